@@ -0,0 +1,138 @@
+package fp
+
+// Identity returns its argument unchanged.
+func Identity[T any](v T) T {
+	return v
+}
+
+// Const returns a function that ignores its argument and always returns v.
+func Const[A any, T any](v T) func(A) T {
+	return func(A) T {
+		return v
+	}
+}
+
+// Pipe returns a function that applies all functions from left to right.
+//
+// For functions with different types, see [Pipe2], [Pipe3] and [Pipe4].
+func Pipe[T any](fns ...func(T) T) func(T) T {
+	return func(v T) T {
+		return Reduce(fns, v, func(value T, fn func(T) T) T {
+			return fn(value)
+		})
+	}
+}
+
+// Compose returns a function that applies all functions from right to left.
+//
+// For functions with different types, see [Compose2], [Compose3] and [Compose4].
+func Compose[T any](fns ...func(T) T) func(T) T {
+	return func(v T) T {
+		for i := len(fns) - 1; i >= 0; i-- {
+			v = fns[i](v)
+		}
+		return v
+	}
+}
+
+// Pipe2 returns a function that applies f and then g.
+func Pipe2[A, B, C any](f func(A) B, g func(B) C) func(A) C {
+	return func(a A) C {
+		return g(f(a))
+	}
+}
+
+// Pipe3 returns a function that applies f, g and then h.
+func Pipe3[A, B, C, D any](f func(A) B, g func(B) C, h func(C) D) func(A) D {
+	return Pipe2(Pipe2(f, g), h)
+}
+
+// Pipe4 returns a function that applies f, g, h and then i.
+func Pipe4[A, B, C, D, E any](f func(A) B, g func(B) C, h func(C) D, i func(D) E) func(A) E {
+	return Pipe2(Pipe3(f, g, h), i)
+}
+
+// Compose2 returns a function that applies g and then f, i.e. f(g(x)).
+func Compose2[A, B, C any](f func(B) C, g func(A) B) func(A) C {
+	return Pipe2(g, f)
+}
+
+// Compose3 returns a function that applies h, g and then f, i.e. f(g(h(x))).
+func Compose3[A, B, C, D any](f func(C) D, g func(B) C, h func(A) B) func(A) D {
+	return Pipe3(h, g, f)
+}
+
+// Compose4 returns a function that applies i, h, g and then f, i.e. f(g(h(i(x)))).
+func Compose4[A, B, C, D, E any](f func(D) E, g func(C) D, h func(B) C, i func(A) B) func(A) E {
+	return Pipe4(i, h, g, f)
+}
+
+// Partial fixes the first argument of a function with two arguments.
+func Partial[A, B, R any](fn func(A, B) R, a A) func(B) R {
+	return func(b B) R {
+		return fn(a, b)
+	}
+}
+
+// Partial3 fixes the first argument of a function with three arguments.
+func Partial3[A, B, C, R any](fn func(A, B, C) R, a A) func(B, C) R {
+	return func(b B, c C) R {
+		return fn(a, b, c)
+	}
+}
+
+// Curry converts a function with two arguments into a chain of functions with one argument each.
+func Curry[A, B, R any](fn func(A, B) R) func(A) func(B) R {
+	return func(a A) func(B) R {
+		return Partial(fn, a)
+	}
+}
+
+// Curry3 converts a function with three arguments into a chain of functions with one argument each.
+func Curry3[A, B, C, R any](fn func(A, B, C) R) func(A) func(B) func(C) R {
+	return func(a A) func(B) func(C) R {
+		return Curry(Partial3(fn, a))
+	}
+}
+
+// Flip swaps the arguments of a function with two arguments.
+func Flip[A, B, R any](fn func(A, B) R) func(B, A) R {
+	return func(b B, a A) R {
+		return fn(a, b)
+	}
+}
+
+// Not negates a predicate.
+func Not[T any](predicate func(T) bool) func(T) bool {
+	return func(v T) bool {
+		return !predicate(v)
+	}
+}
+
+// And returns a predicate that matches if all predicates match.
+//
+// The predicates are evaluated from left to right and evaluation stops at the first mismatch.
+func And[T any](predicates ...func(T) bool) func(T) bool {
+	return func(v T) bool {
+		for _, predicate := range predicates {
+			if !predicate(v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or returns a predicate that matches if any predicate matches.
+//
+// The predicates are evaluated from left to right and evaluation stops at the first match.
+func Or[T any](predicates ...func(T) bool) func(T) bool {
+	return func(v T) bool {
+		for _, predicate := range predicates {
+			if predicate(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
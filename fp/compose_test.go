@@ -0,0 +1,59 @@
+package fp_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/DataInsightHub/Go-Helper/fp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPipeCompose(t *testing.T) {
+	double := func(v int) int { return v * 2 }
+	inc := func(v int) int { return v + 1 }
+
+	t.Run("Should apply functions of the same type in order", func(t *testing.T) {
+		assert.Equal(t, 5, fp.Pipe(double, double, inc)(1))
+		assert.Equal(t, 8, fp.Compose(double, double, inc)(1))
+		assert.Equal(t, 1, fp.Pipe[int]()(1))
+	})
+
+	t.Run("Should apply functions of different types in order", func(t *testing.T) {
+		length := func(s string) int { return len(s) }
+
+		assert.Equal(t, "4", fp.Pipe3(strings.TrimSpace, length, strconv.Itoa)(" abcd "))
+		assert.Equal(t, "4", fp.Compose3(strconv.Itoa, length, strings.TrimSpace)(" abcd "))
+		assert.Equal(t, "5", fp.Pipe4(strings.TrimSpace, length, inc, strconv.Itoa)(" abcd "))
+		assert.Equal(t, "5", fp.Compose4(strconv.Itoa, inc, length, strings.TrimSpace)(" abcd "))
+		assert.Equal(t, 4, fp.Compose2(length, strings.TrimSpace)(" abcd "))
+	})
+}
+
+func TestPartialCurry(t *testing.T) {
+	sub := func(a, b int) int { return a - b }
+	join := func(a, b, c string) string { return a + b + c }
+
+	assert.Equal(t, 7, fp.Partial(sub, 10)(3))
+	assert.Equal(t, "abc", fp.Partial3(join, "a")("b", "c"))
+	assert.Equal(t, 7, fp.Curry(sub)(10)(3))
+	assert.Equal(t, "abc", fp.Curry3(join)("a")("b")("c"))
+	assert.Equal(t, -7, fp.Flip(sub)(10, 3))
+}
+
+func TestIdentityConst(t *testing.T) {
+	assert.Equal(t, []int{1, 2}, fp.Map([]int{1, 2}, fp.Identity[int]))
+	assert.Equal(t, []string{"x", "x"}, fp.Map([]int{1, 2}, fp.Const[int]("x")))
+}
+
+func TestPredicateCombinators(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+	isPositive := func(v int) bool { return v > 0 }
+	slice := []int{-2, -1, 0, 1, 2}
+
+	assert.Equal(t, []int{-1, 1}, fp.Filter(slice, fp.Not(isEven)))
+	assert.Equal(t, []int{2}, fp.Find(slice, fp.And(isEven, isPositive)))
+	assert.Equal(t, []int{0, 2, 3, 4}, fp.FindIndices(slice, fp.Or(isEven, isPositive)))
+	assert.True(t, fp.And[int]()(1))
+	assert.False(t, fp.Or[int]()(1))
+}
@@ -0,0 +1,219 @@
+package fp
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// MergeStrategy determines how [DeepMerge] combines two slices found at the same key.
+type MergeStrategy int
+
+const (
+	// MergeReplace replaces the slice of dst with the slice of src.
+	MergeReplace MergeStrategy = iota
+	// MergeAppend appends all elements of the src slice to the dst slice.
+	MergeAppend
+	// MergeUnion appends all elements of the src slice that are not yet contained in the dst slice.
+	MergeUnion
+)
+
+// DeepMerge merges src into dst and returns the result as a new map.
+//
+// Nested maps are merged recursively, slices are combined according to the strategy
+// and all other values of src overwrite the values of dst.
+// Neither dst nor src are modified, but values that did not need to be merged are shared.
+func DeepMerge(dst, src map[string]any, strategy MergeStrategy) map[string]any {
+	result := make(map[string]any, len(dst)+len(src))
+	for key, value := range dst {
+		result[key] = value
+	}
+
+	for key, value := range src {
+		if existing, exists := result[key]; exists {
+			value = mergeValues(existing, value, strategy)
+		}
+		result[key] = value
+	}
+
+	return result
+}
+
+func mergeValues(dst, src any, strategy MergeStrategy) any {
+	switch s := src.(type) {
+	case map[string]any:
+		if d, ok := dst.(map[string]any); ok {
+			return DeepMerge(d, s, strategy)
+		}
+	case []any:
+		if d, ok := dst.([]any); ok {
+			return mergeSlices(d, s, strategy)
+		}
+	}
+
+	return src
+}
+
+func mergeSlices(dst, src []any, strategy MergeStrategy) []any {
+	switch strategy {
+	case MergeAppend:
+		return Concat(dst, src)
+	case MergeUnion:
+		return Reduce(src, CopySlice(dst), func(result []any, value any) []any {
+			if Contains(result, value) {
+				return result
+			}
+			return append(result, value)
+		})
+	default:
+		return src
+	}
+}
+
+// DiffKind describes how a value differs between the two inputs of [Diff].
+type DiffKind int
+
+const (
+	DiffAdded DiffKind = iota + 1
+	DiffRemoved
+	DiffChanged
+)
+
+func (kind DiffKind) String() string {
+	switch kind {
+	case DiffAdded:
+		return "added"
+	case DiffRemoved:
+		return "removed"
+	case DiffChanged:
+		return "changed"
+	default:
+		return "DiffKind(" + strconv.Itoa(int(kind)) + ")"
+	}
+}
+
+// DiffEntry describes a single difference found by [Diff].
+type DiffEntry struct {
+	// Path is the location of the difference in the syntax of [GetPath], or empty for the root value.
+	Path string
+	Kind DiffKind
+	// Old is the value in a, or nil if the value was added.
+	Old any
+	// New is the value in b, or nil if the value was removed.
+	New any
+}
+
+// Diff compares a and b recursively and returns all differences ordered by path.
+//
+// Maps, structs, slices, arrays and pointers are compared element by element;
+// all other values are compared using reflect.DeepEqual. Unexported struct fields are ignored.
+func Diff(a, b any) []DiffEntry {
+	var entries []DiffEntry
+	diffValues(&entries, "", reflect.ValueOf(a), reflect.ValueOf(b))
+	return entries
+}
+
+func diffValues(entries *[]DiffEntry, path string, a, b reflect.Value) {
+	a, b = unwrapInterface(a), unwrapInterface(b)
+
+	if !a.IsValid() && !b.IsValid() {
+		return
+	}
+
+	if !a.IsValid() || !b.IsValid() || a.Type() != b.Type() {
+		*entries = append(*entries, DiffEntry{Path: path, Kind: DiffChanged, Old: interfaceOf(a), New: interfaceOf(b)})
+		return
+	}
+
+	switch a.Kind() {
+	case reflect.Map:
+		keys := mapKeysSorted(a, b)
+		for _, key := range keys {
+			keyPath := joinPath(path, fmt.Sprint(key.Interface()))
+			aValue, bValue := a.MapIndex(key), b.MapIndex(key)
+
+			switch {
+			case !aValue.IsValid():
+				*entries = append(*entries, DiffEntry{Path: keyPath, Kind: DiffAdded, New: bValue.Interface()})
+			case !bValue.IsValid():
+				*entries = append(*entries, DiffEntry{Path: keyPath, Kind: DiffRemoved, Old: aValue.Interface()})
+			default:
+				diffValues(entries, keyPath, aValue, bValue)
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			field := a.Type().Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			diffValues(entries, joinPath(path, field.Name), a.Field(i), b.Field(i))
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < a.Len() || i < b.Len(); i++ {
+			indexPath := path + "[" + strconv.Itoa(i) + "]"
+
+			switch {
+			case i >= a.Len():
+				*entries = append(*entries, DiffEntry{Path: indexPath, Kind: DiffAdded, New: b.Index(i).Interface()})
+			case i >= b.Len():
+				*entries = append(*entries, DiffEntry{Path: indexPath, Kind: DiffRemoved, Old: a.Index(i).Interface()})
+			default:
+				diffValues(entries, indexPath, a.Index(i), b.Index(i))
+			}
+		}
+	case reflect.Pointer:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() != b.IsNil() {
+				*entries = append(*entries, DiffEntry{Path: path, Kind: DiffChanged, Old: a.Interface(), New: b.Interface()})
+			}
+			return
+		}
+		diffValues(entries, path, a.Elem(), b.Elem())
+	default:
+		if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+			*entries = append(*entries, DiffEntry{Path: path, Kind: DiffChanged, Old: a.Interface(), New: b.Interface()})
+		}
+	}
+}
+
+func unwrapInterface(v reflect.Value) reflect.Value {
+	for v.IsValid() && v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	return v
+}
+
+func interfaceOf(v reflect.Value) any {
+	if !v.IsValid() {
+		return nil
+	}
+	return v.Interface()
+}
+
+func mapKeysSorted(a, b reflect.Value) []reflect.Value {
+	seen := make(map[any]struct{}, a.Len())
+	keys := make([]reflect.Value, 0, a.Len())
+
+	for _, key := range append(a.MapKeys(), b.MapKeys()...) {
+		if _, exists := seen[key.Interface()]; exists {
+			continue
+		}
+		seen[key.Interface()] = struct{}{}
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+	})
+
+	return keys
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
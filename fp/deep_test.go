@@ -0,0 +1,86 @@
+package fp_test
+
+import (
+	"testing"
+
+	"github.com/DataInsightHub/Go-Helper/fp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeepMerge(t *testing.T) {
+	dst := map[string]any{
+		"name": "a",
+		"db":   map[string]any{"host": "localhost", "port": 5432},
+		"tags": []any{"x", "y"},
+	}
+	src := map[string]any{
+		"db":   map[string]any{"port": 6543, "user": "admin"},
+		"tags": []any{"y", "z"},
+	}
+
+	t.Run("Should merge nested maps and replace slices", func(t *testing.T) {
+		result := fp.DeepMerge(dst, src, fp.MergeReplace)
+
+		assert.Equal(t, map[string]any{
+			"name": "a",
+			"db":   map[string]any{"host": "localhost", "port": 6543, "user": "admin"},
+			"tags": []any{"y", "z"},
+		}, result)
+	})
+
+	t.Run("Should append slices", func(t *testing.T) {
+		result := fp.DeepMerge(dst, src, fp.MergeAppend)
+
+		assert.Equal(t, []any{"x", "y", "y", "z"}, result["tags"])
+	})
+
+	t.Run("Should union slices", func(t *testing.T) {
+		result := fp.DeepMerge(dst, src, fp.MergeUnion)
+
+		assert.Equal(t, []any{"x", "y", "z"}, result["tags"])
+	})
+
+	t.Run("Should not modify the inputs", func(t *testing.T) {
+		_ = fp.DeepMerge(dst, src, fp.MergeAppend)
+
+		assert.Equal(t, map[string]any{"host": "localhost", "port": 5432}, dst["db"])
+		assert.Equal(t, []any{"x", "y"}, dst["tags"])
+	})
+}
+
+func TestDiff(t *testing.T) {
+	t.Run("Should diff nested maps and slices", func(t *testing.T) {
+		a := map[string]any{"a": 1, "b": map[string]any{"c": []any{1, 2}}, "d": true}
+		b := map[string]any{"a": 2, "b": map[string]any{"c": []any{1}}, "e": "new"}
+
+		assert.Equal(t, []fp.DiffEntry{
+			{Path: "a", Kind: fp.DiffChanged, Old: 1, New: 2},
+			{Path: "b.c[1]", Kind: fp.DiffRemoved, Old: 2},
+			{Path: "d", Kind: fp.DiffRemoved, Old: true},
+			{Path: "e", Kind: fp.DiffAdded, New: "new"},
+		}, fp.Diff(a, b))
+	})
+
+	t.Run("Should diff structs", func(t *testing.T) {
+		type config struct {
+			Name    string
+			Ports   []int
+			Timeout *int
+			secret  string
+		}
+
+		a := config{Name: "a", Ports: []int{80}, secret: "x"}
+		b := config{Name: "a", Ports: []int{80, 443}, Timeout: fp.ReferenceValue(5), secret: "y"}
+
+		entries := fp.Diff(a, b)
+
+		assert.Equal(t, 2, len(entries))
+		assert.Equal(t, fp.DiffEntry{Path: "Ports[1]", Kind: fp.DiffAdded, New: 443}, entries[0])
+		assert.Equal(t, "Timeout", entries[1].Path)
+		assert.Equal(t, "changed", entries[1].Kind.String())
+	})
+
+	t.Run("Should return nil for equal values", func(t *testing.T) {
+		assert.Nil(t, fp.Diff(map[string]any{"a": []any{1}}, map[string]any{"a": []any{1}}))
+	})
+}
@@ -0,0 +1,147 @@
+package fp
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrNoFutures is returned by [AwaitAny] if no futures are given.
+var ErrNoFutures = errors.New("fp: no futures to await")
+
+// Future represents the [Result] of an operation that is running asynchronously.
+//
+// See [Async]
+type Future[T any] struct {
+	done chan struct{}
+	once sync.Once
+	res  Result[T]
+}
+
+// Async runs fn in a new goroutine and returns a Future for its result.
+//
+// If ctx is done before fn returns, the Future resolves with the error of ctx.
+// fn itself is not interrupted, so it should observe ctx as well.
+func Async[T any](ctx context.Context, fn func() (T, error)) *Future[T] {
+	future := &Future[T]{done: make(chan struct{})}
+
+	go func() {
+		future.resolve(ResultFrom(fn()))
+	}()
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			future.resolve(ResultFrom(ZeroValueOf[T](), ctx.Err()))
+		case <-future.done:
+		}
+	}()
+
+	return future
+}
+
+func (future *Future[T]) resolve(res Result[T]) {
+	future.once.Do(func() {
+		future.res = res
+		close(future.done)
+	})
+}
+
+// Done returns a channel that is closed once the Future is resolved.
+func (future *Future[T]) Done() <-chan struct{} {
+	return future.done
+}
+
+// Await blocks until the Future is resolved and returns its result.
+func (future *Future[T]) Await() Result[T] {
+	<-future.done
+	return future.res
+}
+
+// AwaitTimeout works like [Future.Await], but gives up after the given duration.
+//
+// If the timeout expires, the result contains [context.DeadlineExceeded].
+func (future *Future[T]) AwaitTimeout(timeout time.Duration) Result[T] {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-future.done:
+		return future.res
+	case <-timer.C:
+		return ResultFrom(ZeroValueOf[T](), context.DeadlineExceeded)
+	}
+}
+
+// Then returns a Future that applies fn to the value of future once it is resolved successfully.
+//
+// If future fails, the error is passed on and fn is not called.
+func Then[T any, R any](future *Future[T], fn func(T) (R, error)) *Future[R] {
+	return Async(context.Background(), func() (R, error) {
+		res := future.Await()
+		if err := res.Err(); err != nil {
+			return ZeroValueOf[R](), err
+		}
+		return fn(res.Ok())
+	})
+}
+
+// AwaitAll waits for all futures and returns their values in the given order.
+//
+// It returns as soon as any future fails, with the error of that future.
+func AwaitAll[T any](futures ...*Future[T]) Result[[]T] {
+	values := make([]T, len(futures))
+	completed := awaitInOrderOfCompletion(futures)
+
+	for range futures {
+		i := <-completed
+		res := futures[i].Await()
+		if err := res.Err(); err != nil {
+			return ResultFrom[[]T](nil, err)
+		}
+		values[i] = res.Ok()
+	}
+
+	return ResultFrom(values, nil)
+}
+
+// AwaitAny returns the result of the first future that succeeds.
+//
+// If all futures fail, the result contains the error of the first future that failed.
+func AwaitAny[T any](futures ...*Future[T]) Result[T] {
+	if len(futures) == 0 {
+		return ResultFrom(ZeroValueOf[T](), ErrNoFutures)
+	}
+
+	completed := awaitInOrderOfCompletion(futures)
+
+	var firstErr error
+	for range futures {
+		res := futures[<-completed].Await()
+		if res.Err() == nil {
+			return res
+		}
+		if firstErr == nil {
+			firstErr = res.Err()
+		}
+	}
+
+	return ResultFrom(ZeroValueOf[T](), firstErr)
+}
+
+// awaitInOrderOfCompletion returns a channel that receives the index of each future once it is resolved.
+func awaitInOrderOfCompletion[T any](futures []*Future[T]) <-chan int {
+	completed := make(chan int, len(futures))
+
+	for i := range futures {
+		index := i
+
+		go func() {
+			<-futures[index].done
+			completed <- index
+		}()
+	}
+
+	return completed
+}
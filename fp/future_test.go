@@ -0,0 +1,119 @@
+package fp_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/DataInsightHub/Go-Helper/fp"
+	"github.com/stretchr/testify/assert"
+)
+
+func delayed[T any](d time.Duration, value T, err error) func() (T, error) {
+	return func() (T, error) {
+		time.Sleep(d)
+		return value, err
+	}
+}
+
+func TestAsync(t *testing.T) {
+	t.Run("Should resolve with the result of fn", func(t *testing.T) {
+		res := fp.Async(context.Background(), delayed(0, 1, nil)).Await()
+
+		assert.Equal(t, 1, res.Ok())
+		assert.NoError(t, res.Err())
+	})
+
+	t.Run("Should resolve with the error of fn", func(t *testing.T) {
+		res := fp.Async(context.Background(), delayed(0, 1, errTest)).Await()
+
+		assert.ErrorIs(t, res.Err(), errTest)
+	})
+
+	t.Run("Should resolve with the error of ctx", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+
+		res := fp.Async(ctx, delayed(time.Second, 1, nil)).Await()
+
+		assert.ErrorIs(t, res.Err(), context.DeadlineExceeded)
+	})
+
+	t.Run("Should give up after the timeout", func(t *testing.T) {
+		future := fp.Async(context.Background(), delayed(time.Second, 1, nil))
+
+		res := future.AwaitTimeout(time.Millisecond)
+
+		assert.ErrorIs(t, res.Err(), context.DeadlineExceeded)
+	})
+}
+
+func TestThen(t *testing.T) {
+	t.Run("Should chain futures", func(t *testing.T) {
+		future := fp.Then(fp.Async(context.Background(), delayed(0, 1, nil)), func(v int) (string, error) {
+			return strconv.Itoa(v), nil
+		})
+
+		assert.Equal(t, "1", future.Await().Ok())
+	})
+
+	t.Run("Should pass on errors", func(t *testing.T) {
+		called := false
+		future := fp.Then(fp.Async(context.Background(), delayed(0, 1, errTest)), func(v int) (string, error) {
+			called = true
+			return strconv.Itoa(v), nil
+		})
+
+		assert.ErrorIs(t, future.Await().Err(), errTest)
+		assert.False(t, called)
+	})
+}
+
+func TestAwaitAll(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Should return all values in order", func(t *testing.T) {
+		res := fp.AwaitAll(
+			fp.Async(ctx, delayed(2*time.Millisecond, 1, nil)),
+			fp.Async(ctx, delayed(0, 2, nil)),
+		)
+
+		assert.NoError(t, res.Err())
+		assert.Equal(t, []int{1, 2}, res.Ok())
+	})
+
+	t.Run("Should fail fast", func(t *testing.T) {
+		start := time.Now()
+		res := fp.AwaitAll(
+			fp.Async(ctx, delayed(time.Second, 1, nil)),
+			fp.Async(ctx, delayed(0, 2, errTest)),
+		)
+
+		assert.ErrorIs(t, res.Err(), errTest)
+		assert.Less(t, time.Since(start), time.Second)
+	})
+}
+
+func TestAwaitAny(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Should return the first successful result", func(t *testing.T) {
+		res := fp.AwaitAny(
+			fp.Async(ctx, delayed(0, 1, errTest)),
+			fp.Async(ctx, delayed(time.Second, 2, nil)),
+			fp.Async(ctx, delayed(time.Millisecond, 3, nil)),
+		)
+
+		assert.NoError(t, res.Err())
+		assert.Equal(t, 3, res.Ok())
+	})
+
+	t.Run("Should return an error if all futures fail", func(t *testing.T) {
+		res := fp.AwaitAny(fp.Async(ctx, delayed(0, 1, errTest)))
+		assert.ErrorIs(t, res.Err(), errTest)
+
+		res = fp.AwaitAny[int]()
+		assert.ErrorIs(t, res.Err(), fp.ErrNoFutures)
+	})
+}
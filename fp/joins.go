@@ -0,0 +1,82 @@
+package fp
+
+// Pair holds two values that belong together, e.g. the matching records of a join.
+type Pair[L any, R any] struct {
+	Left  L
+	Right R
+}
+
+// InnerJoin returns a pair for each combination of elements from left and right with equal keys.
+//
+// The pairs are ordered by the left slice, then by the right slice.
+func InnerJoin[L any, R any, K comparable](left []L, right []R, leftKey func(L) K, rightKey func(R) K) []Pair[L, R] {
+	index := GroupBy(right, rightKey)
+
+	var pairs []Pair[L, R]
+
+	for i := range left {
+		for _, match := range index[leftKey(left[i])] {
+			pairs = append(pairs, Pair[L, R]{Left: left[i], Right: match})
+		}
+	}
+
+	return pairs
+}
+
+// LeftJoin works like [InnerJoin], but also keeps the elements of left without a match.
+//
+// Right is nil for the elements of left without a match.
+func LeftJoin[L any, R any, K comparable](left []L, right []R, leftKey func(L) K, rightKey func(R) K) []Pair[L, *R] {
+	index := GroupBy(right, rightKey)
+
+	var pairs []Pair[L, *R]
+
+	for i := range left {
+		matches := index[leftKey(left[i])]
+
+		if IsEmptySlice(matches) {
+			pairs = append(pairs, Pair[L, *R]{Left: left[i]})
+			continue
+		}
+
+		for _, match := range matches {
+			pairs = append(pairs, Pair[L, *R]{Left: left[i], Right: ReferenceValue(match)})
+		}
+	}
+
+	return pairs
+}
+
+// FullOuterJoin works like [LeftJoin], but also keeps the elements of right without a match.
+//
+// The unmatched elements of right are appended after all elements of left, with Left set to nil.
+func FullOuterJoin[L any, R any, K comparable](left []L, right []R, leftKey func(L) K, rightKey func(R) K) []Pair[*L, *R] {
+	leftIndex := GroupBy(left, leftKey)
+
+	pairs := Map(LeftJoin(left, right, leftKey, rightKey), func(pair Pair[L, *R]) Pair[*L, *R] {
+		return Pair[*L, *R]{Left: ReferenceValue(pair.Left), Right: pair.Right}
+	})
+
+	for i := range right {
+		if _, exists := leftIndex[rightKey(right[i])]; !exists {
+			pairs = append(pairs, Pair[*L, *R]{Right: ReferenceValue(right[i])})
+		}
+	}
+
+	return pairs
+}
+
+// SemiJoin returns all elements of left that have at least one match in right.
+//
+// Unlike [InnerJoin], each element of left is returned at most once.
+func SemiJoin[L any, R any, K comparable](left []L, right []R, leftKey func(L) K, rightKey func(R) K) []L {
+	index := make(map[K]struct{}, len(right))
+	for i := range right {
+		index[rightKey(right[i])] = struct{}{}
+	}
+
+	return Filter(left, func(value L) bool {
+		_, exists := index[leftKey(value)]
+		return exists
+	})
+}
@@ -0,0 +1,72 @@
+package fp_test
+
+import (
+	"testing"
+
+	"github.com/DataInsightHub/Go-Helper/fp"
+	"github.com/stretchr/testify/assert"
+)
+
+type joinUser struct {
+	id   int
+	name string
+}
+
+type joinOrder struct {
+	userID int
+	item   string
+}
+
+var (
+	joinUsers = []joinUser{{id: 1, name: "a"}, {id: 2, name: "b"}, {id: 3, name: "c"}}
+
+	joinOrders = []joinOrder{{userID: 1, item: "x"}, {userID: 4, item: "y"}, {userID: 1, item: "z"}}
+
+	joinUserID  = func(u joinUser) int { return u.id }
+	joinOrderID = func(o joinOrder) int { return o.userID }
+)
+
+func TestInnerJoin(t *testing.T) {
+	t.Run("Should return all matching pairs in order", func(t *testing.T) {
+		pairs := fp.InnerJoin(joinUsers, joinOrders, joinUserID, joinOrderID)
+
+		assert.Equal(t, []fp.Pair[joinUser, joinOrder]{
+			{Left: joinUsers[0], Right: joinOrders[0]},
+			{Left: joinUsers[0], Right: joinOrders[2]},
+		}, pairs)
+	})
+
+	t.Run("Should return nil if nothing matches", func(t *testing.T) {
+		pairs := fp.InnerJoin(joinUsers, []joinOrder{}, joinUserID, joinOrderID)
+
+		assert.Empty(t, pairs)
+	})
+}
+
+func TestLeftJoin(t *testing.T) {
+	pairs := fp.LeftJoin(joinUsers, joinOrders, joinUserID, joinOrderID)
+
+	assert.Equal(t, 4, len(pairs))
+	assert.Equal(t, joinOrders[0], *pairs[0].Right)
+	assert.Equal(t, joinOrders[2], *pairs[1].Right)
+	assert.Equal(t, joinUsers[1], pairs[2].Left)
+	assert.Nil(t, pairs[2].Right)
+	assert.Nil(t, pairs[3].Right)
+}
+
+func TestFullOuterJoin(t *testing.T) {
+	pairs := fp.FullOuterJoin(joinUsers, joinOrders, joinUserID, joinOrderID)
+
+	assert.Equal(t, 5, len(pairs))
+	assert.Equal(t, joinUsers[0], *pairs[0].Left)
+	assert.Equal(t, joinOrders[0], *pairs[0].Right)
+	assert.Nil(t, pairs[3].Right)
+	assert.Nil(t, pairs[4].Left)
+	assert.Equal(t, joinOrders[1], *pairs[4].Right)
+}
+
+func TestSemiJoin(t *testing.T) {
+	result := fp.SemiJoin(joinUsers, joinOrders, joinUserID, joinOrderID)
+
+	assert.Equal(t, []joinUser{joinUsers[0]}, result)
+}
@@ -1,5 +1,24 @@
 package fp
 
+import (
+	"fmt"
+	"strings"
+)
+
+// KeyCollisionError is returned by the strict map constructors if two inputs map to the same key.
+type KeyCollisionError[K comparable] struct {
+	// Keys contains every colliding key once, in the order the collisions were detected.
+	Keys []K
+}
+
+func (err *KeyCollisionError[K]) Error() string {
+	keys := Map(err.Keys, func(key K) string {
+		return fmt.Sprintf("%v", key)
+	})
+
+	return fmt.Sprintf("fp: colliding keys: %s", strings.Join(keys, ", "))
+}
+
 // Values returns all values of the given map as a slice in a random order.
 func Values[T any, K comparable](m map[K]T) []T {
 	values := make([]T, len(m))
@@ -31,6 +50,69 @@ func CreateMap[K comparable, T any](keyFunc func(T) K, values []T) map[K]T {
 	return m
 }
 
+// CreateMapStrict creates a new map like [CreateMap], but returns a [*KeyCollisionError]
+// listing all colliding keys instead of overwriting values.
+func CreateMapStrict[K comparable, T any](keyFunc func(T) K, values []T) (map[K]T, error) {
+	m := make(map[K]T, len(values))
+	collisions := newCollisionSet[K]()
+	for _, value := range values {
+		key := keyFunc(value)
+		if _, exists := m[key]; exists {
+			collisions.add(key)
+			continue
+		}
+		m[key] = value
+	}
+	return m, collisions.err()
+}
+
+// CreateMapMerge creates a new map like [CreateMap], but resolves colliding keys with the given function.
+func CreateMapMerge[K comparable, T any](keyFunc func(T) K, values []T, resolve func(existing, incoming T) T) map[K]T {
+	m := make(map[K]T, len(values))
+	for _, value := range values {
+		key := keyFunc(value)
+		if existing, exists := m[key]; exists {
+			value = resolve(existing, value)
+		}
+		m[key] = value
+	}
+	return m
+}
+
+// Associate creates a new map from the key-value pairs returned by fn for each element of the slice.
+//
+// If fn returns the same key twice, the later value wins.
+func Associate[T any, K comparable, V any](slice []T, fn func(T) (K, V)) map[K]V {
+	m := make(map[K]V, len(slice))
+	for i := range slice {
+		key, value := fn(slice[i])
+		m[key] = value
+	}
+	return m
+}
+
+// AssociateBy creates a new map, where each element of the slice is stored under keyFn
+// and transformed by valueFn.
+//
+// If keyFn returns the same key twice, the later value wins.
+func AssociateBy[T any, K comparable, V any](slice []T, keyFn func(T) K, valueFn func(T) V) map[K]V {
+	return Associate(slice, func(value T) (K, V) {
+		return keyFn(value), valueFn(value)
+	})
+}
+
+// IndexMulti groups the elements of a slice by keyFn and transforms them by valueFn.
+//
+// Like [GroupBy], the order of the elements within each group is preserved.
+func IndexMulti[T any, K comparable, V any](slice []T, keyFn func(T) K, valueFn func(T) V) map[K][]V {
+	m := make(map[K][]V)
+	for i := range slice {
+		key := keyFn(slice[i])
+		m[key] = append(m[key], valueFn(slice[i]))
+	}
+	return m
+}
+
 // MapMap creates a new map, where all values have been transformed by the given function.
 func MapMap[K comparable, T any, V any](m map[K]T, fn func(T) V) map[K]V {
 	result := make(map[K]V)
@@ -50,3 +132,152 @@ func MapKey[K1, K2 comparable, V any](m map[K1]V, fn func(K1) K2) map[K2]V {
 	}
 	return result
 }
+
+// MapKeyStrict creates a new map like [MapKey], but returns a [*KeyCollisionError]
+// listing all colliding keys instead of overwriting values.
+//
+// The colliding keys are listed in a random order.
+func MapKeyStrict[K1, K2 comparable, V any](m map[K1]V, fn func(K1) K2) (map[K2]V, error) {
+	result := make(map[K2]V)
+	collisions := newCollisionSet[K2]()
+	for key, value := range m {
+		newKey := fn(key)
+		if _, exists := result[newKey]; exists {
+			collisions.add(newKey)
+			continue
+		}
+		result[newKey] = value
+	}
+	return result, collisions.err()
+}
+
+// MapKeyMerge creates a new map like [MapKey], but resolves colliding keys with the given function.
+//
+// Since maps are iterated in a random order, resolve should not depend on the order of its arguments.
+func MapKeyMerge[K1, K2 comparable, V any](m map[K1]V, fn func(K1) K2, resolve func(existing, incoming V) V) map[K2]V {
+	result := make(map[K2]V)
+	for key, value := range m {
+		newKey := fn(key)
+		if existing, exists := result[newKey]; exists {
+			value = resolve(existing, value)
+		}
+		result[newKey] = value
+	}
+	return result
+}
+
+type collisionSet[K comparable] struct {
+	seen map[K]struct{}
+	keys []K
+}
+
+func newCollisionSet[K comparable]() *collisionSet[K] {
+	return &collisionSet[K]{seen: make(map[K]struct{})}
+}
+
+func (set *collisionSet[K]) add(key K) {
+	if _, exists := set.seen[key]; exists {
+		return
+	}
+	set.seen[key] = struct{}{}
+	set.keys = append(set.keys, key)
+}
+
+func (set *collisionSet[K]) err() error {
+	if len(set.keys) == 0 {
+		return nil
+	}
+	return &KeyCollisionError[K]{Keys: set.keys}
+}
+
+// FilterMap returns a new map with all entries that match the predicate.
+func FilterMap[K comparable, V any](m map[K]V, predicate func(K, V) bool) map[K]V {
+	result := make(map[K]V)
+	for key, value := range m {
+		if predicate(key, value) {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// Merge merges all given maps into a new map.
+//
+// If a key exists in more than one map, conflictFn is called with the existing and the incoming value
+// and its result is stored. If conflictFn is nil, the value of the later map wins.
+func Merge[K comparable, V any](conflictFn func(existing, incoming V) V, maps ...map[K]V) map[K]V {
+	size := Reduce(maps, 0, func(initial int, m map[K]V) int {
+		return initial + len(m)
+	})
+
+	result := make(map[K]V, size)
+	for _, m := range maps {
+		for key, value := range m {
+			if existing, exists := result[key]; exists && conflictFn != nil {
+				value = conflictFn(existing, value)
+			}
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// Invert creates a new map, where keys and values are swapped.
+//
+// If two keys share the same value, one key is overwritten. See [InvertMulti]
+func Invert[K, V comparable](m map[K]V) map[V]K {
+	result := make(map[V]K, len(m))
+	for key, value := range m {
+		result[value] = key
+	}
+	return result
+}
+
+// InvertMulti creates a new map, where keys and values are swapped.
+//
+// All keys sharing the same value are collected in a slice in a random order.
+func InvertMulti[K, V comparable](m map[K]V) map[V][]K {
+	result := make(map[V][]K)
+	for key, value := range m {
+		result[value] = append(result[value], key)
+	}
+	return result
+}
+
+// PickKeys returns a new map that only contains the given keys.
+//
+// Keys that do not exist in m are ignored.
+func PickKeys[K comparable, V any](m map[K]V, keys ...K) map[K]V {
+	result := make(map[K]V, len(keys))
+	for _, key := range keys {
+		if value, exists := m[key]; exists {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// OmitKeys returns a new map that contains all entries except the given keys.
+func OmitKeys[K comparable, V any](m map[K]V, keys ...K) map[K]V {
+	omit := make(map[K]struct{}, len(keys))
+	for _, key := range keys {
+		omit[key] = struct{}{}
+	}
+
+	return FilterMap(m, func(key K, _ V) bool {
+		_, exists := omit[key]
+		return !exists
+	})
+}
+
+// MapEntries creates a new map, where all entries have been transformed by the given function.
+//
+// If fn maps two old keys to the same new key, one value is overwritten.
+func MapEntries[K1, K2 comparable, V1, V2 any](m map[K1]V1, fn func(K1, V1) (K2, V2)) map[K2]V2 {
+	result := make(map[K2]V2)
+	for key, value := range m {
+		newKey, newValue := fn(key, value)
+		result[newKey] = newValue
+	}
+	return result
+}
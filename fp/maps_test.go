@@ -2,6 +2,7 @@ package fp_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/DataInsightHub/Go-Helper/fp"
@@ -34,3 +35,128 @@ func TestMapMap(t *testing.T) {
 	assert.Equal(t, "1", result["a"])
 	assert.Equal(t, "2", result["b"])
 }
+
+func TestFilterMap(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	result := fp.FilterMap(m, func(key string, value int) bool { return key != "a" && value < 3 })
+	assert.Equal(t, map[string]int{"b": 2}, result)
+}
+
+func TestMerge(t *testing.T) {
+	t.Run("Should let the later map win without conflictFn", func(t *testing.T) {
+		result := fp.Merge(nil, map[string]int{"a": 1, "b": 2}, map[string]int{"b": 3})
+		assert.Equal(t, map[string]int{"a": 1, "b": 3}, result)
+	})
+
+	t.Run("Should resolve conflicts with conflictFn", func(t *testing.T) {
+		sum := func(existing, incoming int) int { return existing + incoming }
+		result := fp.Merge(sum, map[string]int{"a": 1, "b": 2}, map[string]int{"b": 3}, map[string]int{"b": 4})
+		assert.Equal(t, map[string]int{"a": 1, "b": 9}, result)
+	})
+}
+
+func TestInvert(t *testing.T) {
+	t.Run("Should swap keys and values", func(t *testing.T) {
+		result := fp.Invert(map[string]int{"a": 1, "b": 2})
+		assert.Equal(t, map[int]string{1: "a", 2: "b"}, result)
+	})
+
+	t.Run("Should collect duplicate values", func(t *testing.T) {
+		result := fp.InvertMulti(map[string]int{"a": 1, "b": 1, "c": 2})
+		assert.ElementsMatch(t, []string{"a", "b"}, result[1])
+		assert.Equal(t, []string{"c"}, result[2])
+	})
+}
+
+func TestPickAndOmitKeys(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+
+	assert.Equal(t, map[string]int{"a": 1, "c": 3}, fp.PickKeys(m, "a", "c", "d"))
+	assert.Equal(t, map[string]int{"b": 2}, fp.OmitKeys(m, "a", "c", "d"))
+}
+
+func TestMapEntries(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	result := fp.MapEntries(m, func(key string, value int) (int, string) {
+		return value, key
+	})
+	assert.Equal(t, map[int]string{1: "a", 2: "b"}, result)
+}
+
+func TestCreateMapStrict(t *testing.T) {
+	t.Run("Should create the map if all keys are unique", func(t *testing.T) {
+		m, err := fp.CreateMapStrict(strings.ToUpper, []string{"a", "b"})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"A": "a", "B": "b"}, m)
+	})
+
+	t.Run("Should list all colliding keys", func(t *testing.T) {
+		_, err := fp.CreateMapStrict(strings.ToUpper, []string{"a", "A", "b", "B", "a"})
+
+		var collisionErr *fp.KeyCollisionError[string]
+		assert.ErrorAs(t, err, &collisionErr)
+		assert.Equal(t, []string{"A", "B"}, collisionErr.Keys)
+		assert.Equal(t, "fp: colliding keys: A, B", err.Error())
+	})
+}
+
+func TestCreateMapMerge(t *testing.T) {
+	m := fp.CreateMapMerge(strings.ToUpper, []string{"a", "A", "b"}, func(existing, incoming string) string {
+		return existing + incoming
+	})
+	assert.Equal(t, map[string]string{"A": "aA", "B": "b"}, m)
+}
+
+func TestMapKeyStrict(t *testing.T) {
+	t.Run("Should transform the keys if all keys are unique", func(t *testing.T) {
+		m, err := fp.MapKeyStrict(map[string]int{"a": 1, "b": 2}, strings.ToUpper)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int{"A": 1, "B": 2}, m)
+	})
+
+	t.Run("Should return an error on colliding keys", func(t *testing.T) {
+		_, err := fp.MapKeyStrict(map[string]int{"a": 1, "A": 2, "b": 3}, strings.ToUpper)
+
+		var collisionErr *fp.KeyCollisionError[string]
+		assert.ErrorAs(t, err, &collisionErr)
+		assert.Equal(t, []string{"A"}, collisionErr.Keys)
+	})
+}
+
+func TestMapKeyMerge(t *testing.T) {
+	sum := func(existing, incoming int) int { return existing + incoming }
+	m := fp.MapKeyMerge(map[string]int{"a": 1, "A": 2, "b": 3}, strings.ToUpper, sum)
+	assert.Equal(t, map[string]int{"A": 3, "B": 3}, m)
+}
+
+func TestAssociate(t *testing.T) {
+	type person struct {
+		id   int
+		name string
+	}
+	persons := []person{{id: 1, name: "a"}, {id: 2, name: "b"}}
+
+	t.Run("Associate should use the returned key-value pairs", func(t *testing.T) {
+		m := fp.Associate(persons, func(p person) (int, string) { return p.id, p.name })
+		assert.Equal(t, map[int]string{1: "a", 2: "b"}, m)
+	})
+
+	t.Run("AssociateBy should project keys and values", func(t *testing.T) {
+		m := fp.AssociateBy(persons,
+			func(p person) string { return p.name },
+			func(p person) int { return p.id })
+		assert.Equal(t, map[string]int{"a": 1, "b": 2}, m)
+	})
+}
+
+func TestIndexMulti(t *testing.T) {
+	slice := []string{"apple", "avocado", "banana", "apricot"}
+	m := fp.IndexMulti(slice,
+		func(s string) byte { return s[0] },
+		strings.ToUpper)
+
+	assert.Equal(t, map[byte][]string{
+		'a': {"APPLE", "AVOCADO", "APRICOT"},
+		'b': {"BANANA"},
+	}, m)
+}
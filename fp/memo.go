@@ -0,0 +1,167 @@
+package fp
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+type MemoizeOption interface {
+	apply(*memoizeOptions)
+}
+
+type (
+	memoizeOptions struct {
+		maxSize int
+		ttl     time.Duration
+	}
+)
+
+type maxSizeOption int
+
+func (maxSizeOption maxSizeOption) apply(options *memoizeOptions) {
+	if maxSizeOption <= 0 {
+		return
+	}
+
+	options.maxSize = int(maxSizeOption)
+}
+
+// WithMaxSize bounds the cache to the given number of entries.
+//
+// If the cache is full, the least recently used entry is evicted.
+func WithMaxSize(maxSize int) MemoizeOption {
+	return maxSizeOption(maxSize)
+}
+
+type ttlOption time.Duration
+
+func (ttlOption ttlOption) apply(options *memoizeOptions) {
+	if ttlOption <= 0 {
+		return
+	}
+
+	options.ttl = time.Duration(ttlOption)
+}
+
+// WithTTL expires cached entries after the given duration.
+//
+// Expired entries are removed when they are looked up or once they become the least recently used entries.
+func WithTTL(ttl time.Duration) MemoizeOption {
+	return ttlOption(ttl)
+}
+
+// Memoize returns a function that caches the results of fn by its argument.
+//
+// The returned function is safe for concurrent use. Concurrent calls with the same uncached
+// argument may call fn more than once. The cache is unbounded unless [WithMaxSize] or [WithTTL] is given.
+func Memoize[K comparable, R any](fn func(K) R, options ...MemoizeOption) func(K) R {
+	return MemoizeWithKey(fn, Identity[K], options...)
+}
+
+// MemoizeWithKey works like [Memoize] for arguments that are not comparable.
+//
+// keyFn maps each argument to the key its result is cached by.
+func MemoizeWithKey[A any, K comparable, R any](fn func(A) R, keyFn func(A) K, options ...MemoizeOption) func(A) R {
+	o := &memoizeOptions{}
+	for _, option := range options {
+		option.apply(o)
+	}
+
+	cache := &memoCache[K, R]{
+		options: o,
+		entries: make(map[K]*list.Element),
+		order:   list.New(),
+	}
+
+	return func(arg A) R {
+		key := keyFn(arg)
+
+		if value, ok := cache.get(key); ok {
+			return value
+		}
+
+		value := fn(arg)
+		cache.set(key, value)
+
+		return value
+	}
+}
+
+type memoEntry[K comparable, R any] struct {
+	key     K
+	value   R
+	expires time.Time
+}
+
+// memoCache keeps its entries in a list ordered from most to least recently used.
+type memoCache[K comparable, R any] struct {
+	mu      sync.Mutex
+	options *memoizeOptions
+	entries map[K]*list.Element
+	order   *list.List
+}
+
+func (cache *memoCache[K, R]) get(key K) (R, bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	element, exists := cache.entries[key]
+	if !exists {
+		return ZeroValueOf[R](), false
+	}
+
+	entry := element.Value.(*memoEntry[K, R])
+	if cache.options.ttl > 0 && time.Now().After(entry.expires) {
+		cache.order.Remove(element)
+		delete(cache.entries, key)
+		return ZeroValueOf[R](), false
+	}
+
+	cache.order.MoveToFront(element)
+
+	return entry.value, true
+}
+
+func (cache *memoCache[K, R]) set(key K, value R) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	entry := &memoEntry[K, R]{key: key, value: value}
+	if cache.options.ttl > 0 {
+		entry.expires = time.Now().Add(cache.options.ttl)
+	}
+
+	if element, exists := cache.entries[key]; exists {
+		element.Value = entry
+		cache.order.MoveToFront(element)
+		return
+	}
+
+	cache.entries[key] = cache.order.PushFront(entry)
+	cache.removeExpired()
+
+	if cache.options.maxSize > 0 && cache.order.Len() > cache.options.maxSize {
+		oldest := cache.order.Back()
+		cache.order.Remove(oldest)
+		delete(cache.entries, oldest.Value.(*memoEntry[K, R]).key)
+	}
+}
+
+// removeExpired removes expired entries from the back of the list, so that entries which are never
+// looked up again do not stay in the cache. It stops at the first entry that has not expired.
+func (cache *memoCache[K, R]) removeExpired() {
+	if cache.options.ttl <= 0 {
+		return
+	}
+
+	now := time.Now()
+	for element := cache.order.Back(); element != nil; element = cache.order.Back() {
+		entry := element.Value.(*memoEntry[K, R])
+		if !now.After(entry.expires) {
+			return
+		}
+		cache.order.Remove(element)
+		delete(cache.entries, entry.key)
+	}
+}
@@ -0,0 +1,88 @@
+package fp_test
+
+import (
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DataInsightHub/Go-Helper/fp"
+	"github.com/stretchr/testify/assert"
+)
+
+func countingUpper(calls *int32) func(string) string {
+	return func(s string) string {
+		atomic.AddInt32(calls, 1)
+		return strings.ToUpper(s)
+	}
+}
+
+func TestMemoize(t *testing.T) {
+	t.Run("Should call fn once per argument", func(t *testing.T) {
+		var calls int32
+		upper := fp.Memoize(countingUpper(&calls))
+
+		assert.Equal(t, "A", upper("a"))
+		assert.Equal(t, "A", upper("a"))
+		assert.Equal(t, "B", upper("b"))
+		assert.Equal(t, int32(2), calls)
+	})
+
+	t.Run("Should be safe for concurrent use", func(t *testing.T) {
+		var calls int32
+		upper := fp.Memoize(countingUpper(&calls), fp.WithMaxSize(2))
+
+		slice := []string{"a", "b", "c", "a", "b", "c", "a", "b", "c"}
+		fp.ForEachParallel(slice, func(index int, s string) {
+			slice[index] = upper(s)
+		})
+
+		assert.Equal(t, []string{"A", "B", "C", "A", "B", "C", "A", "B", "C"}, slice)
+	})
+
+	t.Run("Should evict the least recently used entry", func(t *testing.T) {
+		var calls int32
+		upper := fp.Memoize(countingUpper(&calls), fp.WithMaxSize(2))
+
+		upper("a")
+		upper("b")
+		upper("a")
+		upper("c")
+		assert.Equal(t, int32(3), calls)
+
+		upper("a")
+		assert.Equal(t, int32(3), calls)
+
+		upper("b")
+		assert.Equal(t, int32(4), calls)
+	})
+
+	t.Run("Should expire entries after the TTL", func(t *testing.T) {
+		var calls int32
+		upper := fp.Memoize(countingUpper(&calls), fp.WithTTL(time.Millisecond))
+
+		upper("a")
+		upper("a")
+		assert.Equal(t, int32(1), calls)
+
+		time.Sleep(2 * time.Millisecond)
+
+		upper("a")
+		assert.Equal(t, int32(2), calls)
+	})
+}
+
+func TestMemoizeWithKey(t *testing.T) {
+	var calls int32
+	sum := fp.MemoizeWithKey(func(values []int) int {
+		atomic.AddInt32(&calls, 1)
+		return fp.Reduce(values, 0, func(a, b int) int { return a + b })
+	}, func(values []int) string {
+		return strings.Join(fp.Map(values, func(v int) string { return string(rune('0' + v)) }), ",")
+	})
+
+	assert.Equal(t, 3, sum([]int{1, 2}))
+	assert.Equal(t, 3, sum([]int{1, 2}))
+	assert.Equal(t, 3, sum([]int{2, 1}))
+	assert.Equal(t, int32(2), calls)
+}
@@ -0,0 +1,122 @@
+package fp
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// The type Option represents a value that may be absent.
+//
+// Unlike a pointer in combination with [DereferencePointer], an Option distinguishes
+// between an absent value and a present zero value.
+//
+// The zero value of Option is [None].
+type Option[T any] struct {
+	value T
+	ok    bool
+}
+
+// Some creates an Option containing the given value.
+func Some[T any](v T) Option[T] {
+	return Option[T]{value: v, ok: true}
+}
+
+// None creates an empty Option.
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// OptionFromPointer creates an Option containing the value ptr points to, or [None] if ptr is nil.
+func OptionFromPointer[T any](ptr *T) Option[T] {
+	if ptr == nil {
+		return None[T]()
+	}
+	return Some(*ptr)
+}
+
+// Get returns the value and whether it is present.
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.ok
+}
+
+// GetOrElse returns the value if present, otherwise fallback.
+func (o Option[T]) GetOrElse(fallback T) T {
+	if !o.ok {
+		return fallback
+	}
+	return o.value
+}
+
+// IsSome reports whether the value is present.
+func (o Option[T]) IsSome() bool {
+	return o.ok
+}
+
+// IsNone reports whether the value is absent.
+func (o Option[T]) IsNone() bool {
+	return !o.ok
+}
+
+// Filter returns the Option unchanged if the value is present and matches the predicate, otherwise [None].
+func (o Option[T]) Filter(predicate func(T) bool) Option[T] {
+	if !o.ok || !predicate(o.value) {
+		return None[T]()
+	}
+	return o
+}
+
+// ToPointer returns a pointer to a copy of the value, or nil if the value is absent.
+func (o Option[T]) ToPointer() *T {
+	if !o.ok {
+		return nil
+	}
+	return ReferenceValue(o.value)
+}
+
+// ToResult converts the Option into a [Result], using err if the value is absent.
+func (o Option[T]) ToResult(err error) Result[T] {
+	if !o.ok {
+		return ResultFrom(o.value, err)
+	}
+	return ResultFrom(o.value, nil)
+}
+
+// MarshalJSON encodes the value, or null if the value is absent.
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if !o.ok {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON decodes null into [None] and any other value into [Some].
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = None[T]()
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	*o = Some(value)
+	return nil
+}
+
+// MapOption applies fn to the value if it is present.
+func MapOption[T any, R any](o Option[T], fn func(T) R) Option[R] {
+	if !o.ok {
+		return None[R]()
+	}
+	return Some(fn(o.value))
+}
+
+// FlatMapOption applies fn to the value if it is present and returns the Option returned by fn.
+func FlatMapOption[T any, R any](o Option[T], fn func(T) Option[R]) Option[R] {
+	if !o.ok {
+		return None[R]()
+	}
+	return fn(o.value)
+}
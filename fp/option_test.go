@@ -0,0 +1,104 @@
+package fp_test
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/DataInsightHub/Go-Helper/fp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOption(t *testing.T) {
+	t.Run("Should distinguish a zero value from an absent value", func(t *testing.T) {
+		value, ok := fp.Some(0).Get()
+		assert.True(t, ok)
+		assert.Equal(t, 0, value)
+
+		_, ok = fp.None[int]().Get()
+		assert.False(t, ok)
+
+		var zero fp.Option[int]
+		assert.True(t, zero.IsNone())
+	})
+
+	t.Run("Should return the fallback if the value is absent", func(t *testing.T) {
+		assert.Equal(t, 1, fp.Some(1).GetOrElse(2))
+		assert.Equal(t, 2, fp.None[int]().GetOrElse(2))
+	})
+
+	t.Run("Should filter the value", func(t *testing.T) {
+		isEven := func(v int) bool { return v%2 == 0 }
+
+		assert.True(t, fp.Some(2).Filter(isEven).IsSome())
+		assert.True(t, fp.Some(1).Filter(isEven).IsNone())
+		assert.True(t, fp.None[int]().Filter(isEven).IsNone())
+	})
+
+	t.Run("Should map present values only", func(t *testing.T) {
+		assert.Equal(t, fp.Some("1"), fp.MapOption(fp.Some(1), strconv.Itoa))
+		assert.Equal(t, fp.None[string](), fp.MapOption(fp.None[int](), strconv.Itoa))
+
+		parse := func(s string) fp.Option[int] {
+			v, err := strconv.Atoi(s)
+			if err != nil {
+				return fp.None[int]()
+			}
+			return fp.Some(v)
+		}
+		assert.Equal(t, fp.Some(1), fp.FlatMapOption(fp.Some("1"), parse))
+		assert.Equal(t, fp.None[int](), fp.FlatMapOption(fp.Some("x"), parse))
+	})
+}
+
+func TestOptionConversion(t *testing.T) {
+	t.Run("Should convert from and to pointers", func(t *testing.T) {
+		assert.Equal(t, fp.Some(1), fp.OptionFromPointer(fp.ReferenceValue(1)))
+		assert.Equal(t, fp.None[int](), fp.OptionFromPointer[int](nil))
+
+		assert.Equal(t, 1, *fp.Some(1).ToPointer())
+		assert.Nil(t, fp.None[int]().ToPointer())
+	})
+
+	t.Run("Should convert to results", func(t *testing.T) {
+		res := fp.Some(1).ToResult(errTest)
+		assert.Equal(t, 1, res.Ok())
+		assert.NoError(t, res.Err())
+
+		res = fp.None[int]().ToResult(errTest)
+		assert.ErrorIs(t, res.Err(), errTest)
+	})
+}
+
+func TestOptionJSON(t *testing.T) {
+	type document struct {
+		A fp.Option[int] `json:"a"`
+		B fp.Option[int] `json:"b"`
+	}
+
+	t.Run("Should marshal absent values as null", func(t *testing.T) {
+		b, err := json.Marshal(document{A: fp.Some(0)})
+
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"a": 0, "b": null}`, string(b))
+	})
+
+	t.Run("Should unmarshal null and missing values as None", func(t *testing.T) {
+		var doc document
+		err := json.Unmarshal([]byte(`{"a": null}`), &doc)
+
+		assert.NoError(t, err)
+		assert.True(t, doc.A.IsNone())
+		assert.True(t, doc.B.IsNone())
+
+		err = json.Unmarshal([]byte(`{"a": 0}`), &doc)
+
+		assert.NoError(t, err)
+		assert.Equal(t, fp.Some(0), doc.A)
+	})
+
+	t.Run("Should return decoding errors", func(t *testing.T) {
+		var doc document
+		assert.Error(t, json.Unmarshal([]byte(`{"a": "x"}`), &doc))
+	})
+}
@@ -0,0 +1,363 @@
+package fp
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The path helpers work on documents as returned by encoding/json when decoding into a map[string]any,
+// i.e. nested map[string]any and []any values.
+//
+// A path consists of keys separated by dots and slice indices in brackets, e.g. "a.b[2].c".
+// Keys containing dots or brackets cannot be addressed.
+
+var (
+	// ErrInvalidPath is returned if a path cannot be parsed.
+	ErrInvalidPath = errors.New("invalid path")
+
+	// ErrPathNotFound is returned if a key or index of a path does not exist.
+	ErrPathNotFound = errors.New("path not found")
+)
+
+// PathError records the path and the segment at which a path operation failed.
+type PathError struct {
+	// Path is the full path of the operation.
+	Path string
+	// Segment is the prefix of Path up to and including the failing segment.
+	Segment string
+	// Err is one of [ErrInvalidPath], [ErrPathNotFound] or a [*TypeMismatchError].
+	Err error
+}
+
+func (err *PathError) Error() string {
+	if err.Segment == "" || err.Segment == err.Path {
+		return fmt.Sprintf("fp: path %q: %v", err.Path, err.Err)
+	}
+	return fmt.Sprintf("fp: path %q at %q: %v", err.Path, err.Segment, err.Err)
+}
+
+func (err *PathError) Unwrap() error {
+	return err.Err
+}
+
+// TypeMismatchError reports that a value along a path does not have the expected type.
+type TypeMismatchError struct {
+	Expected string
+	Actual   string
+}
+
+func (err *TypeMismatchError) Error() string {
+	return fmt.Sprintf("expected %s, got %s", err.Expected, err.Actual)
+}
+
+// GetPath returns the value at the given path, asserted to type T.
+//
+// Note that encoding/json decodes all numbers to float64.
+func GetPath[T any](m map[string]any, path string) (T, error) {
+	var zero T
+
+	segments, err := parsePath(path)
+	if err != nil {
+		return zero, err
+	}
+
+	var current any = m
+	for i, segment := range segments {
+		current, err = segment.get(current)
+		if err != nil {
+			return zero, &PathError{Path: path, Segment: formatPath(segments[:i+1]), Err: err}
+		}
+	}
+
+	if current == nil && isNilable[T]() {
+		return zero, nil
+	}
+
+	value, ok := current.(T)
+	if !ok {
+		return zero, &PathError{Path: path, Segment: path, Err: &TypeMismatchError{
+			Expected: reflect.TypeOf((*T)(nil)).Elem().String(),
+			Actual:   typeName(current),
+		}}
+	}
+
+	return value, nil
+}
+
+// SetPath sets the value at the given path.
+//
+// Missing maps and slices along the path are created, and slices are extended with nil values
+// if an index is out of range. m must not be nil.
+func SetPath(m map[string]any, path string, value any) error {
+	segments, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+
+	if m == nil {
+		return &PathError{Path: path, Err: ErrPathNotFound}
+	}
+
+	_, err = setIn(m, segments, 0, path, value)
+	return err
+}
+
+// DeletePath removes the value at the given path.
+//
+// Deleting a slice element shifts all following elements.
+func DeletePath(m map[string]any, path string) error {
+	segments, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+
+	_, err = deleteIn(m, segments, 0, path)
+	return err
+}
+
+// FlattenMap flattens a nested document into a single map with paths as keys,
+// e.g. {"a": {"b": [1]}} becomes {"a.b[0]": 1}.
+//
+// Empty maps and slices are kept as values. See [UnflattenMap]
+func FlattenMap(m map[string]any) map[string]any {
+	result := make(map[string]any)
+	flattenInto(result, "", m)
+	return result
+}
+
+// UnflattenMap reverses [FlattenMap] by setting each value at the path given by its key.
+func UnflattenMap(m map[string]any) (map[string]any, error) {
+	paths := Keys(m)
+	sort.Strings(paths)
+
+	result := make(map[string]any, len(m))
+	for _, path := range paths {
+		if err := SetPath(result, path, m[path]); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+type pathSegment struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+func (segment pathSegment) String() string {
+	if segment.isIndex {
+		return "[" + strconv.Itoa(segment.index) + "]"
+	}
+	return segment.key
+}
+
+func (segment pathSegment) get(container any) (any, error) {
+	if segment.isIndex {
+		slice, ok := container.([]any)
+		if !ok {
+			return nil, &TypeMismatchError{Expected: "[]any", Actual: typeName(container)}
+		}
+		if segment.index >= len(slice) {
+			return nil, ErrPathNotFound
+		}
+		return slice[segment.index], nil
+	}
+
+	m, ok := container.(map[string]any)
+	if !ok {
+		return nil, &TypeMismatchError{Expected: "map[string]any", Actual: typeName(container)}
+	}
+
+	value, exists := m[segment.key]
+	if !exists {
+		return nil, ErrPathNotFound
+	}
+
+	return value, nil
+}
+
+func setIn(container any, segments []pathSegment, i int, path string, value any) (any, error) {
+	if i == len(segments) {
+		return value, nil
+	}
+
+	segment := segments[i]
+	fail := func(err error) (any, error) {
+		return nil, &PathError{Path: path, Segment: formatPath(segments[:i+1]), Err: err}
+	}
+
+	if segment.isIndex {
+		slice, ok := container.([]any)
+		if !ok && container != nil {
+			return fail(&TypeMismatchError{Expected: "[]any", Actual: typeName(container)})
+		}
+
+		for len(slice) <= segment.index {
+			slice = append(slice, nil)
+		}
+
+		element, err := setIn(slice[segment.index], segments, i+1, path, value)
+		if err != nil {
+			return nil, err
+		}
+
+		slice[segment.index] = element
+		return slice, nil
+	}
+
+	m, ok := container.(map[string]any)
+	if !ok && container != nil {
+		return fail(&TypeMismatchError{Expected: "map[string]any", Actual: typeName(container)})
+	}
+
+	if m == nil {
+		m = make(map[string]any)
+	}
+
+	element, err := setIn(m[segment.key], segments, i+1, path, value)
+	if err != nil {
+		return nil, err
+	}
+
+	m[segment.key] = element
+	return m, nil
+}
+
+func deleteIn(container any, segments []pathSegment, i int, path string) (any, error) {
+	segment := segments[i]
+
+	if i < len(segments)-1 {
+		child, err := segment.get(container)
+		if err != nil {
+			return nil, &PathError{Path: path, Segment: formatPath(segments[:i+1]), Err: err}
+		}
+
+		child, err = deleteIn(child, segments, i+1, path)
+		if err != nil {
+			return nil, err
+		}
+
+		if segment.isIndex {
+			container.([]any)[segment.index] = child
+		} else {
+			container.(map[string]any)[segment.key] = child
+		}
+
+		return container, nil
+	}
+
+	if _, err := segment.get(container); err != nil {
+		return nil, &PathError{Path: path, Segment: path, Err: err}
+	}
+
+	if segment.isIndex {
+		slice := container.([]any)
+		return append(slice[:segment.index:segment.index], slice[segment.index+1:]...), nil
+	}
+
+	delete(container.(map[string]any), segment.key)
+	return container, nil
+}
+
+func flattenInto(result map[string]any, prefix string, value any) {
+	switch v := value.(type) {
+	case map[string]any:
+		if len(v) == 0 && prefix != "" {
+			result[prefix] = v
+			return
+		}
+		for key, child := range v {
+			flattenInto(result, joinPath(prefix, key), child)
+		}
+	case []any:
+		if len(v) == 0 {
+			result[prefix] = v
+			return
+		}
+		for i, child := range v {
+			flattenInto(result, prefix+"["+strconv.Itoa(i)+"]", child)
+		}
+	default:
+		result[prefix] = v
+	}
+}
+
+func parsePath(path string) ([]pathSegment, error) {
+	invalid := &PathError{Path: path, Err: ErrInvalidPath}
+
+	if path == "" {
+		return nil, invalid
+	}
+
+	var segments []pathSegment
+
+	for _, part := range strings.Split(path, ".") {
+		key := part
+		rest := ""
+		if i := strings.IndexByte(part, '['); i >= 0 {
+			key, rest = part[:i], part[i:]
+		}
+
+		if key == "" && rest == "" {
+			return nil, invalid
+		}
+
+		if strings.ContainsRune(key, ']') {
+			return nil, invalid
+		}
+
+		if key != "" {
+			segments = append(segments, pathSegment{key: key})
+		}
+
+		for rest != "" {
+			end := strings.IndexByte(rest, ']')
+			if rest[0] != '[' || end < 0 {
+				return nil, invalid
+			}
+
+			index, err := strconv.Atoi(rest[1:end])
+			if err != nil || index < 0 {
+				return nil, invalid
+			}
+
+			segments = append(segments, pathSegment{index: index, isIndex: true})
+			rest = rest[end+1:]
+		}
+	}
+
+	return segments, nil
+}
+
+func formatPath(segments []pathSegment) string {
+	var b strings.Builder
+	for i, segment := range segments {
+		if i > 0 && !segment.isIndex {
+			b.WriteByte('.')
+		}
+		b.WriteString(segment.String())
+	}
+	return b.String()
+}
+
+func typeName(value any) string {
+	if value == nil {
+		return "nil"
+	}
+	return reflect.TypeOf(value).String()
+}
+
+func isNilable[T any]() bool {
+	switch reflect.TypeOf((*T)(nil)).Elem().Kind() {
+	case reflect.Interface, reflect.Pointer, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,136 @@
+package fp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/DataInsightHub/Go-Helper/fp"
+	"github.com/stretchr/testify/assert"
+)
+
+func decodeDocument(t *testing.T, s string) map[string]any {
+	t.Helper()
+
+	var m map[string]any
+	if err := json.Unmarshal([]byte(s), &m); err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestGetPath(t *testing.T) {
+	m := decodeDocument(t, `{"a": {"b": [1, 2, {"c": "value"}], "d": null}}`)
+
+	t.Run("Should return the value at the path", func(t *testing.T) {
+		value, err := fp.GetPath[string](m, "a.b[2].c")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "value", value)
+	})
+
+	t.Run("Should return nested documents", func(t *testing.T) {
+		value, err := fp.GetPath[[]any](m, "a.b")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(value))
+	})
+
+	t.Run("Should return nil for null values of nilable types", func(t *testing.T) {
+		value, err := fp.GetPath[map[string]any](m, "a.d")
+
+		assert.NoError(t, err)
+		assert.Nil(t, value)
+	})
+
+	t.Run("Should return ErrPathNotFound for missing keys and indices", func(t *testing.T) {
+		_, err := fp.GetPath[string](m, "a.x.c")
+		assert.ErrorIs(t, err, fp.ErrPathNotFound)
+		assert.Equal(t, `fp: path "a.x.c" at "a.x": path not found`, err.Error())
+
+		_, err = fp.GetPath[string](m, "a.b[3]")
+		assert.ErrorIs(t, err, fp.ErrPathNotFound)
+	})
+
+	t.Run("Should return a TypeMismatchError if the type does not match", func(t *testing.T) {
+		_, err := fp.GetPath[int](m, "a.b[0]")
+
+		var typeErr *fp.TypeMismatchError
+		assert.ErrorAs(t, err, &typeErr)
+		assert.Equal(t, "int", typeErr.Expected)
+		assert.Equal(t, "float64", typeErr.Actual)
+
+		_, err = fp.GetPath[string](m, "a[0]")
+		assert.ErrorAs(t, err, &typeErr)
+		assert.Equal(t, "[]any", typeErr.Expected)
+	})
+
+	t.Run("Should return ErrInvalidPath for malformed paths", func(t *testing.T) {
+		for _, path := range []string{"", "a..b", "a[", "a[x]", "a[-1]", "a]"} {
+			_, err := fp.GetPath[any](m, path)
+			assert.ErrorIs(t, err, fp.ErrInvalidPath, path)
+		}
+	})
+}
+
+func TestSetPath(t *testing.T) {
+	t.Run("Should create missing maps and slices", func(t *testing.T) {
+		m := map[string]any{}
+
+		assert.NoError(t, fp.SetPath(m, "a.b[1].c", "value"))
+		assert.Equal(t, map[string]any{
+			"a": map[string]any{
+				"b": []any{nil, map[string]any{"c": "value"}},
+			},
+		}, m)
+	})
+
+	t.Run("Should overwrite existing values", func(t *testing.T) {
+		m := decodeDocument(t, `{"a": [1, 2]}`)
+
+		assert.NoError(t, fp.SetPath(m, "a[0]", "x"))
+		assert.Equal(t, []any{"x", float64(2)}, m["a"])
+	})
+
+	t.Run("Should fail if a value along the path has the wrong type", func(t *testing.T) {
+		m := decodeDocument(t, `{"a": "b"}`)
+
+		var typeErr *fp.TypeMismatchError
+		assert.ErrorAs(t, fp.SetPath(m, "a.b", 1), &typeErr)
+	})
+}
+
+func TestDeletePath(t *testing.T) {
+	t.Run("Should delete keys and slice elements", func(t *testing.T) {
+		m := decodeDocument(t, `{"a": {"b": [1, 2, 3], "c": true}}`)
+
+		assert.NoError(t, fp.DeletePath(m, "a.b[1]"))
+		assert.NoError(t, fp.DeletePath(m, "a.c"))
+		assert.Equal(t, map[string]any{"a": map[string]any{"b": []any{float64(1), float64(3)}}}, m)
+	})
+
+	t.Run("Should return ErrPathNotFound for missing paths", func(t *testing.T) {
+		m := decodeDocument(t, `{"a": {"b": [1]}}`)
+
+		assert.ErrorIs(t, fp.DeletePath(m, "a.b[1]"), fp.ErrPathNotFound)
+		assert.ErrorIs(t, fp.DeletePath(m, "a.x.y"), fp.ErrPathNotFound)
+	})
+}
+
+func TestFlattenMap(t *testing.T) {
+	m := decodeDocument(t, `{"a": {"b": [1, {"c": "d"}], "e": {}}, "f": []}`)
+
+	flat := fp.FlattenMap(m)
+	assert.Equal(t, map[string]any{
+		"a.b[0]":   float64(1),
+		"a.b[1].c": "d",
+		"a.e":      map[string]any{},
+		"f":        []any{},
+	}, flat)
+
+	t.Run("Should be reversed by UnflattenMap", func(t *testing.T) {
+		unflat, err := fp.UnflattenMap(flat)
+
+		assert.NoError(t, err)
+		assert.Equal(t, m, unflat)
+	})
+}
@@ -35,3 +35,143 @@ func ResultFrom[T any](val T, err error) Result[T] {
 		err: err,
 	}
 }
+
+// CollectResults returns the values of all results, or the first error if any operation failed.
+func CollectResults[T any](results []Result[T]) ([]T, error) {
+	values := make([]T, 0, len(results))
+
+	for i := range results {
+		if err := results[i].Err(); err != nil {
+			return nil, err
+		}
+		values = append(values, results[i].Ok())
+	}
+
+	return values, nil
+}
+
+// PartitionResults splits the results into the values of the successful operations and the errors of the failed ones.
+//
+// The order of the results is preserved within both slices.
+func PartitionResults[T any](results []Result[T]) ([]T, []error) {
+	var (
+		values []T
+		errs   []error
+	)
+
+	for i := range results {
+		if err := results[i].Err(); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		values = append(values, results[i].Ok())
+	}
+
+	return values, errs
+}
+
+// Traverse applies fn to each element and collects the values into a single result.
+//
+// It stops at the first failed operation and returns its error.
+func Traverse[T any, R any](slice []T, fn func(T) Result[R]) Result[[]R] {
+	values := make([]R, 0, len(slice))
+
+	for i := range slice {
+		res := fn(slice[i])
+		if err := res.Err(); err != nil {
+			return ResultFrom[[]R](nil, err)
+		}
+		values = append(values, res.Ok())
+	}
+
+	return ResultFrom(values, nil)
+}
+
+// IsOk reports whether the operation was successful.
+func IsOk[T any](res Result[T]) bool {
+	return res.Err() == nil
+}
+
+// IsErr reports whether the operation failed.
+func IsErr[T any](res Result[T]) bool {
+	return res.Err() != nil
+}
+
+// MapResult applies fn to the value of a successful result.
+//
+// If the result contains an error, the error is passed on and fn is not called.
+func MapResult[T any, R any](res Result[T], fn func(T) R) Result[R] {
+	if err := res.Err(); err != nil {
+		return ResultFrom(ZeroValueOf[R](), err)
+	}
+	return ResultFrom(fn(res.Ok()), nil)
+}
+
+// FlatMapResult applies fn to the value of a successful result and returns the result of fn.
+//
+// If the result contains an error, the error is passed on and fn is not called.
+func FlatMapResult[T any, R any](res Result[T], fn func(T) Result[R]) Result[R] {
+	if err := res.Err(); err != nil {
+		return ResultFrom(ZeroValueOf[R](), err)
+	}
+	return fn(res.Ok())
+}
+
+// AndThen is an alias for [FlatMapResult].
+func AndThen[T any, R any](res Result[T], fn func(T) Result[R]) Result[R] {
+	return FlatMapResult(res, fn)
+}
+
+// MapErr applies fn to the error of a failed result.
+//
+// A successful result is returned unchanged.
+func MapErr[T any](res Result[T], fn func(error) error) Result[T] {
+	if err := res.Err(); err != nil {
+		return ResultFrom(res.Ok(), fn(err))
+	}
+	return res
+}
+
+// OrElse calls fn with the error of a failed result to recover from it.
+//
+// A successful result is returned unchanged.
+func OrElse[T any](res Result[T], fn func(error) Result[T]) Result[T] {
+	if err := res.Err(); err != nil {
+		return fn(err)
+	}
+	return res
+}
+
+// Unwrap returns the value of a successful result.
+//
+// Panics with the error if the operation failed.
+func Unwrap[T any](res Result[T]) T {
+	if err := res.Err(); err != nil {
+		panic(err)
+	}
+	return res.Ok()
+}
+
+// UnwrapOr returns the value of a successful result, or fallback if the operation failed.
+func UnwrapOr[T any](res Result[T], fallback T) T {
+	if res.Err() != nil {
+		return fallback
+	}
+	return res.Ok()
+}
+
+// UnwrapOrElse returns the value of a successful result, or the value computed by fn if the operation failed.
+func UnwrapOrElse[T any](res Result[T], fn func(error) T) T {
+	if err := res.Err(); err != nil {
+		return fn(err)
+	}
+	return res.Ok()
+}
+
+// Match calls onOk with the value of a successful result or onErr with the error of a failed result.
+func Match[T any, R any](res Result[T], onOk func(T) R, onErr func(error) R) R {
+	if err := res.Err(); err != nil {
+		return onErr(err)
+	}
+	return onOk(res.Ok())
+}
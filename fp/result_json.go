@@ -0,0 +1,136 @@
+package fp
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ErrInvalidEnvelope is returned by [UnmarshalResult] if the envelope contains neither or both of "ok" and "error".
+var ErrInvalidEnvelope = errors.New("fp: invalid result envelope")
+
+// A Result is encoded as a JSON envelope.
+//
+// A successful result is encoded as
+//
+//	{"ok": <value>}
+//
+// and a failed result as
+//
+//	{"error": {"message": "...", "code": "...", "details": <any>}}
+//
+// where code and details are optional. The error is converted by an [ErrorCodec].
+
+// ErrorEnvelope is the JSON representation of the error of a failed [Result].
+type ErrorEnvelope struct {
+	Message string          `json:"message"`
+	Code    string          `json:"code,omitempty"`
+	Details json.RawMessage `json:"details,omitempty"`
+}
+
+// ErrorCodec converts errors to and from their JSON representation.
+//
+// Implement a custom ErrorCodec to let typed errors survive a round trip.
+type ErrorCodec interface {
+	EncodeError(err error) ErrorEnvelope
+	DecodeError(envelope ErrorEnvelope) error
+}
+
+// CodedError is an error with a machine readable code.
+//
+// [DefaultErrorCodec] decodes all errors into a CodedError.
+type CodedError struct {
+	Code    string
+	Message string
+}
+
+func (err *CodedError) Error() string {
+	return err.Message
+}
+
+// ErrorCode returns the code of the error.
+func (err *CodedError) ErrorCode() string {
+	return err.Code
+}
+
+// DefaultErrorCodec encodes the message of an error and, if the error or any error it wraps
+// has an ErrorCode() string method, its code.
+type DefaultErrorCodec struct{}
+
+func (DefaultErrorCodec) EncodeError(err error) ErrorEnvelope {
+	envelope := ErrorEnvelope{Message: err.Error()}
+
+	var coder interface{ ErrorCode() string }
+	if errors.As(err, &coder) {
+		envelope.Code = coder.ErrorCode()
+	}
+
+	return envelope
+}
+
+func (DefaultErrorCodec) DecodeError(envelope ErrorEnvelope) error {
+	return &CodedError{Code: envelope.Code, Message: envelope.Message}
+}
+
+type resultEnvelope struct {
+	Ok    json.RawMessage `json:"ok,omitempty"`
+	Error *ErrorEnvelope  `json:"error,omitempty"`
+}
+
+// MarshalResult encodes the result as JSON envelope, using codec to encode the error.
+//
+// If codec is nil, [DefaultErrorCodec] is used.
+func MarshalResult[T any](res Result[T], codec ErrorCodec) ([]byte, error) {
+	if codec == nil {
+		codec = DefaultErrorCodec{}
+	}
+
+	if err := res.Err(); err != nil {
+		envelope := codec.EncodeError(err)
+		return json.Marshal(resultEnvelope{Error: &envelope})
+	}
+
+	ok, err := json.Marshal(res.Ok())
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(resultEnvelope{Ok: ok})
+}
+
+// UnmarshalResult decodes a JSON envelope into a result, using codec to decode the error.
+//
+// If codec is nil, [DefaultErrorCodec] is used.
+// The returned error is only non-nil if data is not a valid envelope, see [ErrInvalidEnvelope].
+func UnmarshalResult[T any](data []byte, codec ErrorCodec) (Result[T], error) {
+	if codec == nil {
+		codec = DefaultErrorCodec{}
+	}
+
+	var (
+		envelope resultEnvelope
+		value    T
+	)
+
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, err
+	}
+
+	if (envelope.Error != nil) == (len(envelope.Ok) > 0) {
+		return nil, ErrInvalidEnvelope
+	}
+
+	if envelope.Error != nil {
+		return ResultFrom(value, codec.DecodeError(*envelope.Error)), nil
+	}
+
+	if err := json.Unmarshal(envelope.Ok, &value); err != nil {
+		return nil, err
+	}
+
+	return ResultFrom(value, nil), nil
+}
+
+// MarshalJSON encodes the result as JSON envelope using [DefaultErrorCodec].
+func (res result[T]) MarshalJSON() ([]byte, error) {
+	return MarshalResult[T](res, nil)
+}
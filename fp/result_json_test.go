@@ -0,0 +1,115 @@
+package fp_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/DataInsightHub/Go-Helper/fp"
+	"github.com/stretchr/testify/assert"
+)
+
+type notFoundError struct {
+	ID string
+}
+
+func (err *notFoundError) Error() string {
+	return "not found: " + err.ID
+}
+
+type notFoundCodec struct {
+	fp.DefaultErrorCodec
+}
+
+func (codec notFoundCodec) EncodeError(err error) fp.ErrorEnvelope {
+	var notFound *notFoundError
+	if errors.As(err, &notFound) {
+		details, _ := json.Marshal(notFound)
+		return fp.ErrorEnvelope{Message: err.Error(), Code: "not_found", Details: details}
+	}
+	return codec.DefaultErrorCodec.EncodeError(err)
+}
+
+func (codec notFoundCodec) DecodeError(envelope fp.ErrorEnvelope) error {
+	if envelope.Code == "not_found" {
+		var notFound notFoundError
+		if err := json.Unmarshal(envelope.Details, &notFound); err == nil {
+			return &notFound
+		}
+	}
+	return codec.DefaultErrorCodec.DecodeError(envelope)
+}
+
+func TestResultJSON(t *testing.T) {
+	t.Run("Should encode successful results", func(t *testing.T) {
+		b, err := json.Marshal(fp.ResultFrom(map[string]int{"a": 1}, nil))
+
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"ok": {"a": 1}}`, string(b))
+	})
+
+	t.Run("Should encode zero values", func(t *testing.T) {
+		b, err := json.Marshal(fp.ResultFrom[[]int](nil, nil))
+
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"ok": null}`, string(b))
+	})
+
+	t.Run("Should encode failed results with the error code", func(t *testing.T) {
+		res := fp.ResultFrom(0, &fp.CodedError{Code: "invalid", Message: "invalid input"})
+		b, err := json.Marshal(res)
+
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"error": {"message": "invalid input", "code": "invalid"}}`, string(b))
+	})
+
+	t.Run("Should round trip with the default codec", func(t *testing.T) {
+		b, err := fp.MarshalResult(fp.ResultFrom(0, errTest), nil)
+		assert.NoError(t, err)
+
+		res, err := fp.UnmarshalResult[int](b, nil)
+		assert.NoError(t, err)
+		assert.EqualError(t, res.Err(), errTest.Error())
+
+		b, err = fp.MarshalResult(fp.ResultFrom(42, nil), nil)
+		assert.NoError(t, err)
+
+		res, err = fp.UnmarshalResult[int](b, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 42, res.Ok())
+		assert.NoError(t, res.Err())
+	})
+
+	t.Run("Should round trip typed errors with a custom codec", func(t *testing.T) {
+		b, err := fp.MarshalResult(fp.ResultFrom("", &notFoundError{ID: "1"}), notFoundCodec{})
+		assert.NoError(t, err)
+
+		res, err := fp.UnmarshalResult[string](b, notFoundCodec{})
+		assert.NoError(t, err)
+
+		var notFound *notFoundError
+		assert.ErrorAs(t, res.Err(), &notFound)
+		assert.Equal(t, "1", notFound.ID)
+	})
+
+	t.Run("Should return an error for invalid envelopes", func(t *testing.T) {
+		_, err := fp.UnmarshalResult[int]([]byte(`{"ok": "x"}`), nil)
+		assert.Error(t, err)
+
+		_, err = fp.UnmarshalResult[int]([]byte(`[]`), nil)
+		assert.Error(t, err)
+
+		for _, data := range []string{`{}`, `null`, `{"foo": 1}`, `{"ok": 1, "error": {"message": "x"}}`} {
+			_, err = fp.UnmarshalResult[int]([]byte(data), nil)
+			assert.ErrorIs(t, err, fp.ErrInvalidEnvelope, data)
+		}
+	})
+
+	t.Run("Should decode null values", func(t *testing.T) {
+		res, err := fp.UnmarshalResult[*int]([]byte(`{"ok": null}`), nil)
+
+		assert.NoError(t, err)
+		assert.NoError(t, res.Err())
+		assert.Nil(t, res.Ok())
+	})
+}
@@ -0,0 +1,166 @@
+package fp_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/DataInsightHub/Go-Helper/fp"
+	"github.com/stretchr/testify/assert"
+)
+
+var errTest = errors.New("test error")
+
+func TestIsOkIsErr(t *testing.T) {
+	assert.True(t, fp.IsOk(fp.ResultFrom(1, nil)))
+	assert.False(t, fp.IsErr(fp.ResultFrom(1, nil)))
+	assert.False(t, fp.IsOk(fp.ResultFrom(0, errTest)))
+	assert.True(t, fp.IsErr(fp.ResultFrom(0, errTest)))
+}
+
+func TestMapResult(t *testing.T) {
+	t.Run("Should map the value of a successful result", func(t *testing.T) {
+		res := fp.MapResult(fp.ResultFrom(1, nil), strconv.Itoa)
+
+		assert.Equal(t, "1", res.Ok())
+		assert.NoError(t, res.Err())
+	})
+
+	t.Run("Should pass on the error of a failed result", func(t *testing.T) {
+		called := false
+		res := fp.MapResult(fp.ResultFrom(1, errTest), func(v int) string {
+			called = true
+			return strconv.Itoa(v)
+		})
+
+		assert.False(t, called)
+		assert.Equal(t, "", res.Ok())
+		assert.ErrorIs(t, res.Err(), errTest)
+	})
+}
+
+func TestFlatMapResult(t *testing.T) {
+	parse := func(s string) fp.Result[int] { return fp.ResultFrom(strconv.Atoi(s)) }
+
+	t.Run("Should chain successful results", func(t *testing.T) {
+		res := fp.AndThen(fp.ResultFrom("42", nil), parse)
+
+		assert.Equal(t, 42, res.Ok())
+		assert.NoError(t, res.Err())
+	})
+
+	t.Run("Should return the error of fn", func(t *testing.T) {
+		res := fp.FlatMapResult(fp.ResultFrom("x", nil), parse)
+
+		assert.Error(t, res.Err())
+	})
+
+	t.Run("Should pass on the error of a failed result", func(t *testing.T) {
+		res := fp.FlatMapResult(fp.ResultFrom("42", errTest), parse)
+
+		assert.ErrorIs(t, res.Err(), errTest)
+	})
+}
+
+func TestMapErrOrElse(t *testing.T) {
+	t.Run("MapErr should wrap the error", func(t *testing.T) {
+		res := fp.MapErr(fp.ResultFrom(0, errTest), func(err error) error {
+			return errors.New("wrapped: " + err.Error())
+		})
+
+		assert.EqualError(t, res.Err(), "wrapped: test error")
+	})
+
+	t.Run("OrElse should recover from the error", func(t *testing.T) {
+		res := fp.OrElse(fp.ResultFrom(0, errTest), func(error) fp.Result[int] {
+			return fp.ResultFrom(1, nil)
+		})
+
+		assert.Equal(t, 1, res.Ok())
+		assert.NoError(t, res.Err())
+	})
+
+	t.Run("Should return successful results unchanged", func(t *testing.T) {
+		res := fp.ResultFrom(2, nil)
+
+		assert.Equal(t, res, fp.MapErr(res, func(err error) error { return err }))
+		assert.Equal(t, res, fp.OrElse(res, func(error) fp.Result[int] { return fp.ResultFrom(1, nil) }))
+	})
+}
+
+func TestUnwrap(t *testing.T) {
+	ok := fp.ResultFrom(1, nil)
+	failed := fp.ResultFrom(1, errTest)
+
+	assert.Equal(t, 1, fp.Unwrap(ok))
+	assert.PanicsWithError(t, errTest.Error(), func() { fp.Unwrap(failed) })
+
+	assert.Equal(t, 1, fp.UnwrapOr(ok, 2))
+	assert.Equal(t, 2, fp.UnwrapOr(failed, 2))
+
+	assert.Equal(t, 1, fp.UnwrapOrElse(ok, func(error) int { return 3 }))
+	assert.Equal(t, 3, fp.UnwrapOrElse(failed, func(error) int { return 3 }))
+}
+
+func TestMatch(t *testing.T) {
+	onOk := func(v int) string { return "ok " + strconv.Itoa(v) }
+	onErr := func(err error) string { return "err " + err.Error() }
+
+	assert.Equal(t, "ok 1", fp.Match(fp.ResultFrom(1, nil), onOk, onErr))
+	assert.Equal(t, "err test error", fp.Match(fp.ResultFrom(1, errTest), onOk, onErr))
+}
+
+func TestCollectResults(t *testing.T) {
+	t.Run("Should collect all values", func(t *testing.T) {
+		values, err := fp.CollectResults([]fp.Result[int]{fp.ResultFrom(1, nil), fp.ResultFrom(2, nil)})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2}, values)
+	})
+
+	t.Run("Should return the first error", func(t *testing.T) {
+		otherErr := errors.New("other error")
+		values, err := fp.CollectResults([]fp.Result[int]{
+			fp.ResultFrom(1, nil),
+			fp.ResultFrom(0, errTest),
+			fp.ResultFrom(0, otherErr),
+		})
+
+		assert.ErrorIs(t, err, errTest)
+		assert.Nil(t, values)
+	})
+}
+
+func TestPartitionResults(t *testing.T) {
+	values, errs := fp.PartitionResults([]fp.Result[int]{
+		fp.ResultFrom(1, nil),
+		fp.ResultFrom(0, errTest),
+		fp.ResultFrom(3, nil),
+	})
+
+	assert.Equal(t, []int{1, 3}, values)
+	assert.Equal(t, []error{errTest}, errs)
+}
+
+func TestTraverse(t *testing.T) {
+	parse := func(s string) fp.Result[int] { return fp.ResultFrom(strconv.Atoi(s)) }
+
+	t.Run("Should collect all values", func(t *testing.T) {
+		res := fp.Traverse([]string{"1", "2"}, parse)
+
+		assert.NoError(t, res.Err())
+		assert.Equal(t, []int{1, 2}, res.Ok())
+	})
+
+	t.Run("Should stop at the first error", func(t *testing.T) {
+		calls := 0
+		res := fp.Traverse([]string{"1", "x", "3"}, func(s string) fp.Result[int] {
+			calls++
+			return parse(s)
+		})
+
+		assert.Error(t, res.Err())
+		assert.Nil(t, res.Ok())
+		assert.Equal(t, 2, calls)
+	})
+}
@@ -0,0 +1,123 @@
+package fp
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+)
+
+const maxTraceDepth = 32
+
+// TracedError wraps an error with the call stack of its origin and a chain of context annotations.
+//
+// Format it with %+v to print the annotations and the stack. See [TracedResultFrom] and [WithContext]
+type TracedError struct {
+	err     error
+	pcs     []uintptr
+	context []string
+}
+
+func newTracedError(err error, skip int) *TracedError {
+	pcs := make([]uintptr, maxTraceDepth)
+	n := runtime.Callers(skip+2, pcs)
+
+	return &TracedError{err: err, pcs: pcs[:n]}
+}
+
+// Error returns the message of the wrapped error, prefixed by the annotations with the latest first.
+func (err *TracedError) Error() string {
+	var b strings.Builder
+	for i := len(err.context) - 1; i >= 0; i-- {
+		b.WriteString(err.context[i])
+		b.WriteString(": ")
+	}
+	b.WriteString(err.err.Error())
+	return b.String()
+}
+
+// Unwrap returns the wrapped error.
+func (err *TracedError) Unwrap() error {
+	return err.err
+}
+
+// Context returns the annotations with the earliest first.
+func (err *TracedError) Context() []string {
+	return CopySlice(err.context)
+}
+
+// Frames returns the call stack captured where the error was wrapped, with the innermost frame first.
+func (err *TracedError) Frames() []runtime.Frame {
+	var frames []runtime.Frame
+
+	if len(err.pcs) == 0 {
+		return frames
+	}
+
+	iter := runtime.CallersFrames(err.pcs)
+	for {
+		frame, more := iter.Next()
+		frames = append(frames, frame)
+		if !more {
+			return frames
+		}
+	}
+}
+
+// Format implements fmt.Formatter.
+//
+// %s and %v print the error message, %+v additionally prints the annotations and the call stack.
+func (err *TracedError) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if s.Flag('+') {
+			fmt.Fprintf(s, "%+v", err.err)
+			for i := len(err.context) - 1; i >= 0; i-- {
+				fmt.Fprintf(s, "\n  context: %s", err.context[i])
+			}
+			for _, frame := range err.Frames() {
+				fmt.Fprintf(s, "\n%s\n\t%s:%d", frame.Function, frame.File, frame.Line)
+			}
+			return
+		}
+		fallthrough
+	case 's':
+		io.WriteString(s, err.Error())
+	case 'q':
+		fmt.Fprintf(s, "%q", err.Error())
+	}
+}
+
+// TracedResultFrom creates a new Result like [ResultFrom].
+//
+// If err is not nil, it is wrapped in a [*TracedError] that records the call stack of the caller.
+func TracedResultFrom[T any](val T, err error) Result[T] {
+	if err == nil {
+		return ResultFrom(val, nil)
+	}
+	return ResultFrom[T](val, newTracedError(err, 1))
+}
+
+// WithContext annotates the error of a failed result with a formatted message.
+//
+// If the error is not yet a [*TracedError], it is wrapped in one that records the call stack of the caller.
+// A successful result is returned unchanged.
+func WithContext[T any](res Result[T], format string, args ...any) Result[T] {
+	err := res.Err()
+	if err == nil {
+		return res
+	}
+
+	traced, ok := err.(*TracedError)
+	if !ok {
+		traced = newTracedError(err, 1)
+	}
+
+	annotated := &TracedError{
+		err:     traced.err,
+		pcs:     traced.pcs,
+		context: append(CopySlice(traced.context), fmt.Sprintf(format, args...)),
+	}
+
+	return ResultFrom[T](res.Ok(), annotated)
+}
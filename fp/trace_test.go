@@ -0,0 +1,67 @@
+package fp_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/DataInsightHub/Go-Helper/fp"
+	"github.com/stretchr/testify/assert"
+)
+
+func loadUser(id int) fp.Result[string] {
+	return fp.TracedResultFrom("", errTest)
+}
+
+func TestTracedResultFrom(t *testing.T) {
+	t.Run("Should record the caller", func(t *testing.T) {
+		res := loadUser(1)
+
+		var traced *fp.TracedError
+		assert.ErrorAs(t, res.Err(), &traced)
+		assert.ErrorIs(t, res.Err(), errTest)
+		assert.True(t, strings.HasSuffix(traced.Frames()[0].Function, "fp_test.loadUser"))
+	})
+
+	t.Run("Should not wrap nil errors", func(t *testing.T) {
+		assert.NoError(t, fp.TracedResultFrom(1, nil).Err())
+	})
+}
+
+func TestWithContext(t *testing.T) {
+	t.Run("Should annotate the error", func(t *testing.T) {
+		res := fp.WithContext(fp.WithContext(loadUser(1), "loading user %d", 1), "handling request")
+
+		assert.EqualError(t, res.Err(), "handling request: loading user 1: test error")
+		assert.ErrorIs(t, res.Err(), errTest)
+
+		var traced *fp.TracedError
+		assert.ErrorAs(t, res.Err(), &traced)
+		assert.Equal(t, []string{"loading user 1", "handling request"}, traced.Context())
+		assert.True(t, strings.HasSuffix(traced.Frames()[0].Function, "fp_test.loadUser"))
+	})
+
+	t.Run("Should wrap untraced errors", func(t *testing.T) {
+		res := fp.WithContext(fp.ResultFrom(0, errTest), "context")
+
+		var traced *fp.TracedError
+		assert.ErrorAs(t, res.Err(), &traced)
+		assert.True(t, strings.HasSuffix(traced.Frames()[0].Function, "TestWithContext.func2"))
+	})
+
+	t.Run("Should print the stack with %+v", func(t *testing.T) {
+		err := fp.WithContext(loadUser(1), "loading user %d", 1).Err()
+
+		assert.Equal(t, "loading user 1: test error", fmt.Sprintf("%v", err))
+		assert.Equal(t, `"loading user 1: test error"`, fmt.Sprintf("%q", err))
+
+		verbose := fmt.Sprintf("%+v", err)
+		assert.True(t, strings.HasPrefix(verbose, "test error\n  context: loading user 1\n"))
+		assert.Contains(t, verbose, "trace_test.go")
+	})
+
+	t.Run("Should return successful results unchanged", func(t *testing.T) {
+		res := fp.ResultFrom(1, nil)
+		assert.Equal(t, res, fp.WithContext(res, "context"))
+	})
+}
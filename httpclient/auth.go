@@ -0,0 +1,207 @@
+package httpclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Authenticator adds credentials to a request before it is sent.
+//
+// It is called for every attempt of a request, before circuit breaking, rate limiting and the middlewares.
+// Its errors are returned as [*AuthError].
+type Authenticator interface {
+	Authenticate(r *http.Request) error
+}
+
+// AuthError is returned if the [Authenticator] of the client fails.
+//
+// The request is not sent, and it is neither retried nor counted as failure of the host by the circuit breaker.
+type AuthError struct {
+	Err error
+}
+
+func (err *AuthError) Error() string {
+	return "httpclient: authentication failed: " + err.Err.Error()
+}
+
+func (err *AuthError) Unwrap() error {
+	return err.Err
+}
+
+// AuthenticatorFunc adapts a function to an [Authenticator].
+type AuthenticatorFunc func(r *http.Request) error
+
+func (fn AuthenticatorFunc) Authenticate(r *http.Request) error {
+	return fn(r)
+}
+
+type authOption struct {
+	authenticator Authenticator
+}
+
+func (authOption authOption) apply(options *clientOptions) {
+	options.authenticator = authOption.authenticator
+}
+
+// WithAuth authenticates every request of the client.
+func WithAuth(authenticator Authenticator) ClientOption {
+	return authOption{authenticator: authenticator}
+}
+
+// BearerToken sets a static token in the Authorization header.
+func BearerToken(token string) Authenticator {
+	return AuthenticatorFunc(func(r *http.Request) error {
+		r.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	})
+}
+
+// BasicAuth sets the Authorization header for HTTP basic authentication.
+func BasicAuth(username string, password string) Authenticator {
+	return AuthenticatorFunc(func(r *http.Request) error {
+		r.SetBasicAuth(username, password)
+		return nil
+	})
+}
+
+// APIKeyHeader sets the API key in the given header, e.g. "X-API-Key".
+func APIKeyHeader(header string, key string) Authenticator {
+	return AuthenticatorFunc(func(r *http.Request) error {
+		r.Header.Set(header, key)
+		return nil
+	})
+}
+
+// APIKeyQuery sets the API key as the given query parameter, e.g. "api_key".
+//
+// The key is redacted in the URLs of errors and responses, see [WithRedactedQueryParams].
+func APIKeyQuery(param string, key string) Authenticator {
+	return apiKeyQuery{param: param, key: key}
+}
+
+type apiKeyQuery struct {
+	param string
+	key   string
+}
+
+func (auth apiKeyQuery) Authenticate(r *http.Request) error {
+	query := r.URL.Query()
+	query.Set(auth.param, auth.key)
+	r.URL.RawQuery = query.Encode()
+	return nil
+}
+
+func (auth apiKeyQuery) redactedQueryParams() []string {
+	return []string{auth.param}
+}
+
+// OAuth2Config configures the OAuth2 client credentials grant (RFC 6749, section 4.4).
+type OAuth2Config struct {
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	// HTTPClient is used to request tokens. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+	// RefreshBefore is the time before expiry at which a token is refreshed. Defaults to 30 seconds.
+	RefreshBefore time.Duration
+}
+
+// OAuth2ClientCredentials authenticates requests with a bearer token obtained by the client credentials grant.
+//
+// The token is cached and refreshed shortly before it expires. Tokens without expires_in never expire.
+// It is safe for concurrent use.
+type OAuth2ClientCredentials struct {
+	config OAuth2Config
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+// NewOAuth2ClientCredentials creates an [Authenticator] for the OAuth2 client credentials grant.
+func NewOAuth2ClientCredentials(config OAuth2Config) *OAuth2ClientCredentials {
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.DefaultClient
+	}
+	if config.RefreshBefore <= 0 {
+		config.RefreshBefore = 30 * time.Second
+	}
+
+	return &OAuth2ClientCredentials{config: config}
+}
+
+type oauth2Token struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+func (auth *OAuth2ClientCredentials) Authenticate(r *http.Request) error {
+	auth.mu.Lock()
+	defer auth.mu.Unlock()
+
+	expired := !auth.expires.IsZero() && !time.Now().Add(auth.config.RefreshBefore).Before(auth.expires)
+	if auth.token == "" || expired {
+		if err := auth.refresh(r); err != nil {
+			return err
+		}
+	}
+
+	r.Header.Set("Authorization", "Bearer "+auth.token)
+	return nil
+}
+
+func (auth *OAuth2ClientCredentials) refresh(r *http.Request) error {
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if len(auth.config.Scopes) > 0 {
+		form.Set("scope", strings.Join(auth.config.Scopes, " "))
+	}
+
+	tokenRequest, err := http.NewRequestWithContext(r.Context(), http.MethodPost, auth.config.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+
+	tokenRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	tokenRequest.SetBasicAuth(url.QueryEscape(auth.config.ClientID), url.QueryEscape(auth.config.ClientSecret))
+
+	res, err := auth.config.HTTPClient.Do(tokenRequest)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if !isSuccessStatus(res.StatusCode) {
+		return newHTTPError(res, tokenRequest.URL.Redacted(), body, &requestOptions{})
+	}
+
+	var token oauth2Token
+	if err := json.Unmarshal(body, &token); err != nil {
+		return err
+	}
+
+	if token.AccessToken == "" {
+		return fmt.Errorf("httpclient: token response of %s contains no access token", auth.config.TokenURL)
+	}
+
+	auth.token = token.AccessToken
+	auth.expires = time.Time{}
+	if token.ExpiresIn > 0 {
+		auth.expires = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+
+	return nil
+}
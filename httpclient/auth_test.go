@@ -0,0 +1,212 @@
+package httpclient_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DataInsightHub/Go-Helper/fp"
+	"github.com/DataInsightHub/Go-Helper/httpclient"
+	"github.com/stretchr/testify/assert"
+)
+
+func newAuthEchoServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"authorization": %q, "apiKey": %q, "query": %q}`,
+			r.Header.Get("Authorization"), r.Header.Get("X-API-Key"), r.URL.RawQuery)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestStaticAuth(t *testing.T) {
+	server := newAuthEchoServer(t)
+
+	for name, test := range map[string]struct {
+		auth     httpclient.Authenticator
+		field    string
+		expected string
+	}{
+		"bearer":     {httpclient.BearerToken("token"), "authorization", "Bearer token"},
+		"basic":      {httpclient.BasicAuth("user", "pass"), "authorization", "Basic dXNlcjpwYXNz"},
+		"api header": {httpclient.APIKeyHeader("X-API-Key", "key"), "apiKey", "key"},
+		"api query":  {httpclient.APIKeyQuery("api_key", "key"), "query", "api_key=key&page=2"},
+	} {
+		t.Run("Should authenticate with "+name, func(t *testing.T) {
+			client := httpclient.NewClient(httpclient.WithAuth(test.auth))
+
+			res, err := httpclient.Get[map[string]string](client, server.URL+"?page=2", nil)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, res[test.field])
+		})
+	}
+}
+
+func TestOAuth2ClientCredentials(t *testing.T) {
+	var tokenRequests int32
+
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&tokenRequests, 1)
+
+		clientID, clientSecret, _ := r.BasicAuth()
+		if clientID != "id" || clientSecret != "secret" || r.FormValue("grant_type") != "client_credentials" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error": "invalid_client"}`))
+			return
+		}
+
+		assert.Equal(t, "read write", r.FormValue("scope"))
+
+		_, _ = fmt.Fprintf(w, `{"access_token": "token-%d", "token_type": "Bearer", "expires_in": 60}`, n)
+	}))
+	defer tokenServer.Close()
+
+	server := newAuthEchoServer(t)
+
+	t.Run("Should request and cache the token", func(t *testing.T) {
+		atomic.StoreInt32(&tokenRequests, 0)
+		auth := httpclient.NewOAuth2ClientCredentials(httpclient.OAuth2Config{
+			TokenURL:     tokenServer.URL,
+			ClientID:     "id",
+			ClientSecret: "secret",
+			Scopes:       []string{"read", "write"},
+		})
+		client := httpclient.NewClient(httpclient.WithAuth(auth))
+
+		fp.ForEachParallel(make([]int, 5), func(_ int, _ int) {
+			res, err := httpclient.Get[map[string]string](client, server.URL, nil)
+
+			assert.NoError(t, err)
+			assert.Equal(t, "Bearer token-1", res["authorization"])
+		})
+
+		assert.Equal(t, int32(1), atomic.LoadInt32(&tokenRequests))
+	})
+
+	t.Run("Should refresh the token before it expires", func(t *testing.T) {
+		atomic.StoreInt32(&tokenRequests, 0)
+		auth := httpclient.NewOAuth2ClientCredentials(httpclient.OAuth2Config{
+			TokenURL:      tokenServer.URL,
+			ClientID:      "id",
+			ClientSecret:  "secret",
+			Scopes:        []string{"read", "write"},
+			RefreshBefore: time.Minute,
+		})
+		client := httpclient.NewClient(httpclient.WithAuth(auth))
+
+		for i := 1; i <= 2; i++ {
+			res, err := httpclient.Get[map[string]string](client, server.URL, nil)
+
+			assert.NoError(t, err)
+			assert.Equal(t, fmt.Sprintf("Bearer token-%d", i), res["authorization"])
+		}
+	})
+
+	t.Run("Should keep tokens without expiry", func(t *testing.T) {
+		var requests int32
+		tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			_, _ = w.Write([]byte(`{"access_token": "token", "token_type": "Bearer"}`))
+		}))
+		defer tokenServer.Close()
+
+		auth := httpclient.NewOAuth2ClientCredentials(httpclient.OAuth2Config{TokenURL: tokenServer.URL})
+		client := httpclient.NewClient(httpclient.WithAuth(auth))
+
+		for i := 0; i < 2; i++ {
+			res, err := httpclient.Get[map[string]string](client, server.URL, nil)
+
+			assert.NoError(t, err)
+			assert.Equal(t, "Bearer token", res["authorization"])
+		}
+
+		assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+	})
+
+	t.Run("Should return an HTTPError if the token request fails", func(t *testing.T) {
+		auth := httpclient.NewOAuth2ClientCredentials(httpclient.OAuth2Config{
+			TokenURL:     tokenServer.URL,
+			ClientID:     "id",
+			ClientSecret: "wrong",
+		})
+		client := httpclient.NewClient(httpclient.WithAuth(auth))
+
+		_, err := httpclient.Get[map[string]string](client, server.URL, nil)
+
+		var (
+			authErr *httpclient.AuthError
+			httpErr *httpclient.HTTPError
+		)
+		assert.ErrorAs(t, err, &authErr)
+		assert.ErrorAs(t, err, &httpErr)
+		assert.Equal(t, http.StatusUnauthorized, httpErr.StatusCode)
+	})
+
+	t.Run("Should neither retry nor count failed token requests as failures of the host", func(t *testing.T) {
+		atomic.StoreInt32(&tokenRequests, 0)
+		auth := httpclient.NewOAuth2ClientCredentials(httpclient.OAuth2Config{
+			TokenURL:     tokenServer.URL,
+			ClientID:     "id",
+			ClientSecret: "wrong",
+		})
+		client := httpclient.NewClient(
+			httpclient.WithAuth(auth),
+			httpclient.WithRetryPolicy(httpclient.RetryPolicy{InitialBackoff: time.Millisecond}),
+			httpclient.WithCircuitBreaker(httpclient.CircuitBreakerConfig{MinRequests: 1}),
+		)
+
+		_, err := httpclient.Get[map[string]string](client, server.URL, nil)
+
+		var authErr *httpclient.AuthError
+		assert.ErrorAs(t, err, &authErr)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&tokenRequests))
+		assert.Equal(t, httpclient.CircuitClosed, client.CircuitState(strings.TrimPrefix(server.URL, "http://")))
+	})
+}
+
+func TestRedactedQueryParams(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/error" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	t.Run("Should redact the API key in errors", func(t *testing.T) {
+		client := httpclient.NewClient(httpclient.WithAuth(httpclient.APIKeyQuery("api_key", "secret")))
+
+		_, err := httpclient.Get[map[string]string](client, server.URL+"/error?page=2", nil)
+
+		var httpErr *httpclient.HTTPError
+		assert.ErrorAs(t, err, &httpErr)
+		assert.Equal(t, server.URL+"/error?api_key=xxxxx&page=2", httpErr.URL)
+		assert.NotContains(t, err.Error(), "secret")
+	})
+
+	t.Run("Should redact the API key in transport errors", func(t *testing.T) {
+		client := httpclient.NewClient(httpclient.WithAuth(httpclient.APIKeyQuery("api_key", "secret")))
+
+		_, err := httpclient.Get[map[string]string](client, "http://127.0.0.1:1/x", nil)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "api_key=xxxxx")
+		assert.NotContains(t, err.Error(), "secret")
+	})
+
+	t.Run("Should redact the given query parameters in responses", func(t *testing.T) {
+		client := httpclient.NewClient(httpclient.WithRedactedQueryParams("token"))
+
+		res, err := httpclient.GetResponse[map[string]string](client, server.URL+"/?token=secret&page=2", nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, server.URL+"/?token=xxxxx&page=2", res.URL)
+	})
+}
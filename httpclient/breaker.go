@@ -0,0 +1,274 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is matched by every [*CircuitOpenError].
+var ErrCircuitOpen = errors.New("httpclient: circuit open")
+
+// CircuitOpenError is returned without sending the request if the circuit breaker of the host is open.
+type CircuitOpenError struct {
+	Host string
+	// OpenUntil is the time at which the circuit becomes half-open again.
+	OpenUntil time.Time
+}
+
+func (err *CircuitOpenError) Error() string {
+	return fmt.Sprintf("httpclient: circuit open for host %s until %s", err.Host, err.OpenUntil.Format(time.RFC3339))
+}
+
+func (err *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// CircuitState is the state of the circuit breaker of a host.
+type CircuitState int
+
+const (
+	// CircuitClosed lets all requests pass.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen rejects all requests until the cool-down has passed.
+	CircuitOpen
+	// CircuitHalfOpen lets a limited number of trial requests pass to decide whether to close or open the circuit.
+	CircuitHalfOpen
+)
+
+func (state CircuitState) String() string {
+	switch state {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "CircuitState(" + strconv.Itoa(int(state)) + ")"
+	}
+}
+
+// CircuitBreakerConfig configures the circuit breaker of a client.
+//
+// The zero value of each field selects its default.
+type CircuitBreakerConfig struct {
+	// FailureRate opens the circuit once the ratio of failed requests within the window reaches it. Defaults to 0.5.
+	FailureRate float64
+	// MinRequests is the number of requests within the window before the failure rate is evaluated. Defaults to 10.
+	MinRequests int
+	// Window is the duration after which the request counts are reset. Defaults to 1 minute.
+	Window time.Duration
+	// CoolDown is the duration the circuit stays open before it becomes half-open. Defaults to 30 seconds.
+	CoolDown time.Duration
+	// HalfOpenRequests is the number of successful trial requests needed to close the circuit again. Defaults to 1.
+	HalfOpenRequests int
+	// IsFailure decides whether a request failed, res is nil if err is not nil. Defaults to transport errors and 5xx responses.
+	// Canceled requests are never counted.
+	IsFailure func(res *http.Response, err error) bool
+	// OnStateChange is called on every state change, e.g. to raise an alert.
+	OnStateChange func(host string, from, to CircuitState)
+}
+
+type circuitBreakerOption CircuitBreakerConfig
+
+func (circuitBreakerOption circuitBreakerOption) apply(options *clientOptions) {
+	config := CircuitBreakerConfig(circuitBreakerOption)
+
+	if config.FailureRate <= 0 || config.FailureRate > 1 {
+		config.FailureRate = 0.5
+	}
+	if config.MinRequests <= 0 {
+		config.MinRequests = 10
+	}
+	if config.Window <= 0 {
+		config.Window = time.Minute
+	}
+	if config.CoolDown <= 0 {
+		config.CoolDown = 30 * time.Second
+	}
+	if config.HalfOpenRequests <= 0 {
+		config.HalfOpenRequests = 1
+	}
+	if config.IsFailure == nil {
+		config.IsFailure = isServerFailure
+	}
+
+	options.circuitBreaker = &config
+}
+
+// WithCircuitBreaker adds a circuit breaker for each host, which fails fast with a [*CircuitOpenError]
+// while the host is considered down.
+func WithCircuitBreaker(config CircuitBreakerConfig) ClientOption {
+	return circuitBreakerOption(config)
+}
+
+func isServerFailure(res *http.Response, err error) bool {
+	return err != nil || res.StatusCode >= 500
+}
+
+type circuitBreaker struct {
+	config *CircuitBreakerConfig
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+}
+
+type circuit struct {
+	state       CircuitState
+	windowStart time.Time
+	requests    int
+	failures    int
+	openedAt    time.Time
+	trials      int
+	successes   int
+}
+
+type stateChange struct {
+	host     string
+	from, to CircuitState
+}
+
+func newCircuitBreaker(config *CircuitBreakerConfig) *circuitBreaker {
+	if config == nil {
+		return nil
+	}
+
+	return &circuitBreaker{config: config, circuits: make(map[string]*circuit)}
+}
+
+func (breaker *circuitBreaker) circuit(host string) *circuit {
+	c, exists := breaker.circuits[host]
+	if !exists {
+		c = &circuit{windowStart: time.Now()}
+		breaker.circuits[host] = c
+	}
+	return c
+}
+
+func (breaker *circuitBreaker) state(host string) CircuitState {
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+
+	c, exists := breaker.circuits[host]
+	if !exists {
+		return CircuitClosed
+	}
+
+	return c.state
+}
+
+// allow reports whether a request to host may be sent.
+func (breaker *circuitBreaker) allow(host string) error {
+	var changes []stateChange
+	defer func() { breaker.notify(changes) }()
+
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+
+	c := breaker.circuit(host)
+	now := time.Now()
+
+	switch c.state {
+	case CircuitOpen:
+		openUntil := c.openedAt.Add(breaker.config.CoolDown)
+		if now.Before(openUntil) {
+			return &CircuitOpenError{Host: host, OpenUntil: openUntil}
+		}
+
+		changes = append(changes, breaker.transition(host, c, CircuitHalfOpen))
+		fallthrough
+	case CircuitHalfOpen:
+		if c.trials >= breaker.config.HalfOpenRequests {
+			return &CircuitOpenError{Host: host, OpenUntil: now}
+		}
+		c.trials++
+	case CircuitClosed:
+		if now.Sub(c.windowStart) >= breaker.config.Window {
+			c.windowStart, c.requests, c.failures = now, 0, 0
+		}
+	}
+
+	return nil
+}
+
+// record updates the circuit of host with the outcome of an allowed request.
+func (breaker *circuitBreaker) record(host string, res *http.Response, err error) {
+	var changes []stateChange
+	defer func() { breaker.notify(changes) }()
+
+	canceled := errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+	failed := !canceled && breaker.config.IsFailure(res, err)
+
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+
+	c := breaker.circuit(host)
+
+	switch c.state {
+	case CircuitClosed:
+		if canceled {
+			return
+		}
+
+		c.requests++
+		if failed {
+			c.failures++
+		}
+
+		if c.requests >= breaker.config.MinRequests && float64(c.failures)/float64(c.requests) >= breaker.config.FailureRate {
+			changes = append(changes, breaker.transition(host, c, CircuitOpen))
+		}
+	case CircuitHalfOpen:
+		c.trials--
+
+		switch {
+		case canceled:
+		case failed:
+			changes = append(changes, breaker.transition(host, c, CircuitOpen))
+		default:
+			c.successes++
+			if c.successes >= breaker.config.HalfOpenRequests {
+				changes = append(changes, breaker.transition(host, c, CircuitClosed))
+			}
+		}
+	}
+}
+
+func (breaker *circuitBreaker) transition(host string, c *circuit, to CircuitState) stateChange {
+	change := stateChange{host: host, from: c.state, to: to}
+
+	now := time.Now()
+	*c = circuit{state: to, windowStart: now}
+	if to == CircuitOpen {
+		c.openedAt = now
+	}
+
+	return change
+}
+
+func (breaker *circuitBreaker) notify(changes []stateChange) {
+	if breaker.config.OnStateChange == nil {
+		return
+	}
+
+	for _, change := range changes {
+		breaker.config.OnStateChange(change.host, change.from, change.to)
+	}
+}
+
+// CircuitState returns the state of the circuit breaker for the given host.
+//
+// The host is given as in the request URL, i.e. with a port only if the URL contains one,
+// e.g. "api.example.com" for "https://api.example.com/users" and "localhost:8080" for "http://localhost:8080/users".
+// Returns [CircuitClosed] if no circuit breaker is configured or no request to the host was sent yet.
+func (client *HttpClient) CircuitState(host string) CircuitState {
+	if client.breaker == nil {
+		return CircuitClosed
+	}
+	return client.breaker.state(host)
+}
@@ -0,0 +1,108 @@
+package httpclient_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DataInsightHub/Go-Helper/httpclient"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	var (
+		failing int32 = 1
+		calls   int32
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if atomic.LoadInt32(&failing) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	host := serverURL.Host
+
+	var (
+		mu      sync.Mutex
+		changes []string
+	)
+
+	client := httpclient.NewClient(
+		httpclient.WithCircuitBreaker(httpclient.CircuitBreakerConfig{
+			FailureRate: 0.5,
+			MinRequests: 2,
+			CoolDown:    20 * time.Millisecond,
+			OnStateChange: func(h string, from, to httpclient.CircuitState) {
+				mu.Lock()
+				defer mu.Unlock()
+				assert.Equal(t, host, h)
+				changes = append(changes, from.String()+" -> "+to.String())
+			},
+		}),
+		httpclient.WithRetryPolicy(httpclient.RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Millisecond, StatusCodes: []int{500}}),
+	)
+
+	t.Run("Should open the circuit once the failure rate is reached", func(t *testing.T) {
+		_, err := httpclient.Get[map[string]any](client, server.URL, nil)
+
+		assert.ErrorIs(t, err, httpclient.ErrCircuitOpen)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+		assert.Equal(t, httpclient.CircuitOpen, client.CircuitState(host))
+
+		var openErr *httpclient.CircuitOpenError
+		assert.ErrorAs(t, err, &openErr)
+		assert.Equal(t, host, openErr.Host)
+	})
+
+	t.Run("Should fail fast while the circuit is open", func(t *testing.T) {
+		_, err := httpclient.Get[map[string]any](client, server.URL, nil)
+
+		assert.ErrorIs(t, err, httpclient.ErrCircuitOpen)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("Should open the circuit again if the trial request fails", func(t *testing.T) {
+		time.Sleep(30 * time.Millisecond)
+
+		_, err := httpclient.Get[map[string]any](client, server.URL, nil)
+
+		assert.ErrorIs(t, err, httpclient.ErrCircuitOpen)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+		assert.Equal(t, httpclient.CircuitOpen, client.CircuitState(host))
+	})
+
+	t.Run("Should close the circuit if the trial request succeeds", func(t *testing.T) {
+		atomic.StoreInt32(&failing, 0)
+		time.Sleep(30 * time.Millisecond)
+
+		_, err := httpclient.Get[map[string]any](client, server.URL, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, httpclient.CircuitClosed, client.CircuitState(host))
+	})
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	assert.Equal(t, []string{
+		"closed -> open",
+		"open -> half-open",
+		"half-open -> open",
+		"open -> half-open",
+		"half-open -> closed",
+	}, changes)
+}
+
+func TestCircuitStateWithoutBreaker(t *testing.T) {
+	assert.Equal(t, httpclient.CircuitClosed, httpclient.NewClient().CircuitState("example.com"))
+}
@@ -0,0 +1,281 @@
+package httpclient
+
+import (
+	"crypto/tls"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type ClientOption interface {
+	apply(*clientOptions)
+}
+
+type (
+	clientOptions struct {
+		httpClient     *http.Client
+		transport      http.RoundTripper
+		timeout        *time.Duration
+		baseURL        string
+		headers        map[string]string
+		proxy          func(*http.Request) (*url.URL, error)
+		tlsConfig      *tls.Config
+		redirectPolicy func(req *http.Request, via []*http.Request) error
+		retryPolicy    *RetryPolicy
+		limits         limiterOptions
+		circuitBreaker *CircuitBreakerConfig
+		middlewares    []Middleware
+		authenticator  Authenticator
+		signer         Signer
+		redactedParams []string
+	}
+)
+
+type timeoutOption time.Duration
+
+func (timeoutOption timeoutOption) apply(options *clientOptions) {
+	timeout := time.Duration(timeoutOption)
+
+	if timeout < 0 {
+		return
+	}
+
+	options.timeout = &timeout
+}
+
+// WithTimeout limits the time of each request including reading the response body.
+//
+// A timeout of zero means no timeout.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return timeoutOption(timeout)
+}
+
+type baseURLOption string
+
+func (baseURLOption baseURLOption) apply(options *clientOptions) {
+	options.baseURL = strings.TrimRight(string(baseURLOption), "/")
+}
+
+// WithBaseURL prefixes all request URLs that are not absolute with the given base URL.
+func WithBaseURL(baseURL string) ClientOption {
+	return baseURLOption(baseURL)
+}
+
+type headersOption map[string]string
+
+func (headersOption headersOption) apply(options *clientOptions) {
+	if options.headers == nil {
+		options.headers = make(map[string]string, len(headersOption))
+	}
+
+	for key, value := range headersOption {
+		options.headers[key] = value
+	}
+}
+
+// WithDefaultHeaders sets headers that are sent with every request.
+//
+// The headers given to a single request take precedence.
+func WithDefaultHeaders(headers map[string]string) ClientOption {
+	return headersOption(headers)
+}
+
+// WithUserAgent sets the User-Agent header of every request.
+func WithUserAgent(userAgent string) ClientOption {
+	return headersOption{"User-Agent": userAgent}
+}
+
+type httpClientOption struct {
+	httpClient *http.Client
+}
+
+func (httpClientOption httpClientOption) apply(options *clientOptions) {
+	options.httpClient = httpClientOption.httpClient
+}
+
+// WithHTTPClient uses a copy of the given client to send requests.
+//
+// The other options are applied to the copy, the given client is not modified.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return httpClientOption{httpClient: httpClient}
+}
+
+type transportOption struct {
+	transport http.RoundTripper
+}
+
+func (transportOption transportOption) apply(options *clientOptions) {
+	options.transport = transportOption.transport
+}
+
+// WithTransport sets the http.RoundTripper used to send requests.
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return transportOption{transport: transport}
+}
+
+type proxyOption func(*http.Request) (*url.URL, error)
+
+func (proxyOption proxyOption) apply(options *clientOptions) {
+	options.proxy = proxyOption
+}
+
+// WithProxy sends all requests through the given proxy.
+//
+// Like [WithTLSConfig], it only takes effect if the transport is an *http.Transport.
+func WithProxy(proxyURL *url.URL) ClientOption {
+	return proxyOption(http.ProxyURL(proxyURL))
+}
+
+type tlsConfigOption struct {
+	tlsConfig *tls.Config
+}
+
+func (tlsConfigOption tlsConfigOption) apply(options *clientOptions) {
+	options.tlsConfig = tlsConfigOption.tlsConfig
+}
+
+// WithTLSConfig sets the TLS configuration of the transport.
+//
+// Like [WithProxy], it only takes effect if the transport is an *http.Transport.
+func WithTLSConfig(tlsConfig *tls.Config) ClientOption {
+	return tlsConfigOption{tlsConfig: tlsConfig}
+}
+
+type redirectPolicyOption func(req *http.Request, via []*http.Request) error
+
+func (redirectPolicyOption redirectPolicyOption) apply(options *clientOptions) {
+	options.redirectPolicy = redirectPolicyOption
+}
+
+// WithRedirectPolicy sets the policy for following redirects, see http.Client.CheckRedirect.
+func WithRedirectPolicy(policy func(req *http.Request, via []*http.Request) error) ClientOption {
+	return redirectPolicyOption(policy)
+}
+
+// WithoutRedirects returns redirect responses instead of following them.
+func WithoutRedirects() ClientOption {
+	return redirectPolicyOption(func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	})
+}
+
+type redactedQueryParamsOption []string
+
+func (redactedQueryParamsOption redactedQueryParamsOption) apply(options *clientOptions) {
+	options.redactedParams = append(options.redactedParams, redactedQueryParamsOption...)
+}
+
+// WithRedactedQueryParams hides the values of the given query parameters in [HTTPError.URL] and [Response.URL],
+// e.g. for credentials passed in the URL. The parameter of [APIKeyQuery] is redacted automatically.
+func WithRedactedQueryParams(params ...string) ClientOption {
+	return redactedQueryParamsOption(params)
+}
+
+// redactedQueryParams returns the query parameters to redact, including those of the authenticator.
+func (options *clientOptions) redactedQueryParams() []string {
+	params := options.redactedParams
+
+	if redactor, ok := options.authenticator.(interface{ redactedQueryParams() []string }); ok {
+		params = append(append([]string(nil), params...), redactor.redactedQueryParams()...)
+	}
+
+	return params
+}
+
+func (options *clientOptions) newHTTPClient() *http.Client {
+	httpClient := &http.Client{}
+	if options.httpClient != nil {
+		*httpClient = *options.httpClient
+	}
+
+	if options.timeout != nil {
+		httpClient.Timeout = *options.timeout
+	}
+
+	if options.redirectPolicy != nil {
+		httpClient.CheckRedirect = options.redirectPolicy
+	}
+
+	if options.transport != nil {
+		httpClient.Transport = options.transport
+	}
+
+	if options.proxy == nil && options.tlsConfig == nil {
+		return httpClient
+	}
+
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	if t, ok := transport.(*http.Transport); ok {
+		t = t.Clone()
+
+		if options.proxy != nil {
+			t.Proxy = options.proxy
+		}
+
+		if options.tlsConfig != nil {
+			t.TLSClientConfig = options.tlsConfig
+		}
+
+		httpClient.Transport = t
+	}
+
+	return httpClient
+}
+
+// redactURL returns the URL with the password and the values of the redacted query parameters replaced by "xxxxx".
+func (client *HttpClient) redactURL(u *url.URL) string {
+	if len(client.redactedParams) == 0 || u.RawQuery == "" {
+		return u.Redacted()
+	}
+
+	pairs := strings.Split(u.RawQuery, "&")
+	for i, pair := range pairs {
+		key, _, _ := strings.Cut(pair, "=")
+		name, err := url.QueryUnescape(key)
+		if err != nil {
+			continue
+		}
+
+		for _, param := range client.redactedParams {
+			if name == param {
+				pairs[i] = key + "=xxxxx"
+				break
+			}
+		}
+	}
+
+	redacted := *u
+	redacted.RawQuery = strings.Join(pairs, "&")
+
+	return redacted.Redacted()
+}
+
+// redactError redacts the URL of a [*url.Error] in the chain of err, as returned for transport errors.
+func (client *HttpClient) redactError(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		if u, parseErr := url.Parse(urlErr.URL); parseErr == nil {
+			urlErr.URL = client.redactURL(u)
+		}
+	}
+
+	return err
+}
+
+func (client *HttpClient) resolveURL(rawURL string) string {
+	if client.baseURL == "" {
+		return rawURL
+	}
+
+	if parsed, err := url.Parse(rawURL); err == nil && parsed.IsAbs() {
+		return rawURL
+	}
+
+	return client.baseURL + "/" + strings.TrimLeft(rawURL, "/")
+}
@@ -0,0 +1,146 @@
+package httpclient_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/DataInsightHub/Go-Helper/httpclient"
+	"github.com/stretchr/testify/assert"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (fn roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return fn(r)
+}
+
+func newHeaderServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/slow":
+			time.Sleep(50 * time.Millisecond)
+		case "/redirect":
+			w.Header().Set("Location", "/")
+			w.WriteHeader(http.StatusFound)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"path": "` + r.URL.Path + `", "userAgent": "` + r.UserAgent() + `", "header": "` + r.Header.Get("X-Test") + `"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestClientOptions(t *testing.T) {
+	server := newHeaderServer(t)
+
+	t.Run("Should resolve relative URLs against the base URL", func(t *testing.T) {
+		client := httpclient.NewClient(httpclient.WithBaseURL(server.URL + "/v1/"))
+
+		res, err := httpclient.Get[map[string]string](client, "/users", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "/v1/users", res["path"])
+
+		res, err = httpclient.Get[map[string]string](client, server.URL+"/other", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "/other", res["path"])
+
+		res, err = httpclient.Get[map[string]string](client, "/cb?next=https://example.com", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "/v1/cb", res["path"])
+	})
+
+	t.Run("Should send default headers and the user agent", func(t *testing.T) {
+		client := httpclient.NewClient(
+			httpclient.WithDefaultHeaders(map[string]string{"X-Test": "default"}),
+			httpclient.WithUserAgent("test-agent"),
+		)
+
+		res, err := httpclient.Get[map[string]string](client, server.URL, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "default", res["header"])
+		assert.Equal(t, "test-agent", res["userAgent"])
+
+		res, err = httpclient.Get[map[string]string](client, server.URL, map[string]string{"X-Test": "request"})
+		assert.NoError(t, err)
+		assert.Equal(t, "request", res["header"])
+	})
+
+	t.Run("Should time out", func(t *testing.T) {
+		client := httpclient.NewClient(httpclient.WithTimeout(10 * time.Millisecond))
+
+		_, err := httpclient.Get[map[string]string](client, server.URL+"/slow", nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("Should apply the redirect policy", func(t *testing.T) {
+		client := httpclient.NewClient(httpclient.WithoutRedirects())
+
+		res, err := httpclient.GetResponse[map[string]string](client, server.URL+"/redirect", nil, httpclient.WithoutStatusCheck())
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusFound, res.StatusCode)
+	})
+
+	t.Run("Should use the given transport", func(t *testing.T) {
+		calls := 0
+		transport := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			calls++
+			return http.DefaultTransport.RoundTrip(r)
+		})
+		client := httpclient.NewClient(httpclient.WithTransport(transport))
+
+		_, err := httpclient.Get[map[string]string](client, server.URL, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Should not modify the given http.Client", func(t *testing.T) {
+		httpClient := &http.Client{}
+		client := httpclient.NewClient(httpclient.WithHTTPClient(httpClient), httpclient.WithTimeout(time.Second))
+
+		_, err := httpclient.Get[map[string]string](client, server.URL, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Duration(0), httpClient.Timeout)
+	})
+
+	t.Run("Should send requests through the proxy", func(t *testing.T) {
+		var proxied string
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			proxied = r.URL.String()
+			_, _ = w.Write([]byte(`{}`))
+		}))
+		defer proxy.Close()
+
+		proxyURL, _ := url.Parse(proxy.URL)
+		client := httpclient.NewClient(httpclient.WithProxy(proxyURL))
+
+		_, err := httpclient.Get[map[string]string](client, "http://example.invalid/path", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "http://example.invalid/path", proxied)
+	})
+
+	t.Run("Should use the TLS config", func(t *testing.T) {
+		tlsServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{}`))
+		}))
+		defer tlsServer.Close()
+
+		_, err := httpclient.Get[map[string]string](httpclient.NewClient(), tlsServer.URL, nil)
+		assert.Error(t, err)
+
+		pool := x509.NewCertPool()
+		pool.AddCert(tlsServer.Certificate())
+		client := httpclient.NewClient(httpclient.WithTLSConfig(&tls.Config{RootCAs: pool}))
+
+		_, err = httpclient.Get[map[string]string](client, tlsServer.URL, nil)
+		assert.NoError(t, err)
+	})
+}
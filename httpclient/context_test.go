@@ -0,0 +1,55 @@
+package httpclient_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DataInsightHub/Go-Helper/httpclient"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestContext(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/body" {
+			_, _ = w.Write([]byte(`{"a": `))
+			w.(http.Flusher).Flush()
+		}
+
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := httpclient.NewClient()
+
+	t.Run("Should abort the request when the context is canceled", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := httpclient.GetCtx[map[string]int](ctx, client, server.URL, nil)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("Should abort reading the body when the context is canceled", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := httpclient.PostCtx[map[string]int](ctx, client, server.URL+"/body", nil, nil)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("Should accept the context as request option", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := httpclient.Get[map[string]int](client, server.URL, nil, httpclient.WithContext(ctx))
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
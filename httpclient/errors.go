@@ -0,0 +1,72 @@
+package httpclient
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// maxErrorBodySize limits the number of bytes of the response body kept in an [HTTPError].
+const maxErrorBodySize = 4096
+
+// HTTPError is returned for responses with a non-2xx status code.
+type HTTPError struct {
+	Method string
+	// URL is the URL of the request with the password and redacted query parameters hidden, see [WithRedactedQueryParams].
+	URL        string
+	StatusCode int
+	Status     string
+	Header     http.Header
+	// Body is the raw response body, truncated to 4 KiB.
+	Body []byte
+	// ErrorBody is the decoded response body if [WithErrorBody] was given and decoding succeeded.
+	ErrorBody any
+}
+
+func newHTTPError(res *http.Response, url string, body []byte, options *requestOptions) *HTTPError {
+	err := &HTTPError{
+		Method:     res.Request.Method,
+		URL:        url,
+		StatusCode: res.StatusCode,
+		Status:     res.Status,
+		Header:     res.Header,
+		Body:       body,
+	}
+
+	if len(body) > maxErrorBodySize {
+		err.Body = append([]byte(nil), body[:maxErrorBodySize]...)
+	}
+
+	if options.decodeErrorBody != nil && len(body) > 0 {
+		if errorBody, decodeErr := options.decodeErrorBody(body); decodeErr == nil {
+			err.ErrorBody = errorBody
+		}
+	}
+
+	return err
+}
+
+func (err *HTTPError) Error() string {
+	return fmt.Sprintf("httpclient: %s %s: %s", err.Method, err.URL, err.Status)
+}
+
+// ErrorBodyAs returns the decoded error body of an [*HTTPError] in the chain of err.
+//
+// The second return value is false if err contains no HTTPError or its error body is not of type E.
+func ErrorBodyAs[E any](err error) (E, bool) {
+	var (
+		httpErr *HTTPError
+		e       E
+	)
+
+	if !errors.As(err, &httpErr) {
+		return e, false
+	}
+
+	e, ok := httpErr.ErrorBody.(E)
+	return e, ok
+}
+
+func isSuccessStatus(statusCode int) bool {
+	return statusCode >= 200 && statusCode < 300
+}
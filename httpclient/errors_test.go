@@ -0,0 +1,69 @@
+package httpclient_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DataInsightHub/Go-Helper/httpclient"
+	"github.com/stretchr/testify/assert"
+)
+
+type apiError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func TestHTTPError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/html":
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte("<html>" + strings.Repeat("x", 5000) + "</html>"))
+		case "/json":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code": "invalid", "message": "invalid input"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := httpclient.NewClient()
+
+	t.Run("Should return an HTTPError for non-2xx responses", func(t *testing.T) {
+		_, err := httpclient.Get[map[string]any](client, server.URL+"/html", nil)
+
+		var httpErr *httpclient.HTTPError
+		assert.ErrorAs(t, err, &httpErr)
+		assert.Equal(t, http.StatusInternalServerError, httpErr.StatusCode)
+		assert.Equal(t, http.MethodGet, httpErr.Method)
+		assert.Equal(t, server.URL+"/html", httpErr.URL)
+		assert.Equal(t, "text/html", httpErr.Header.Get("Content-Type"))
+		assert.Equal(t, 4096, len(httpErr.Body))
+		assert.Equal(t, "httpclient: GET "+server.URL+"/html: 500 Internal Server Error", err.Error())
+	})
+
+	t.Run("Should decode typed error bodies", func(t *testing.T) {
+		_, err := httpclient.Post[map[string]any](client, server.URL+"/json", nil, nil, httpclient.WithErrorBody[apiError]())
+
+		body, ok := httpclient.ErrorBodyAs[apiError](err)
+		assert.True(t, ok)
+		assert.Equal(t, apiError{Code: "invalid", Message: "invalid input"}, body)
+	})
+
+	t.Run("Should keep the HTTPError if the error body cannot be decoded", func(t *testing.T) {
+		_, err := httpclient.Get[map[string]any](client, server.URL+"/html", nil, httpclient.WithErrorBody[apiError]())
+
+		_, ok := httpclient.ErrorBodyAs[apiError](err)
+		assert.False(t, ok)
+		assert.Error(t, err)
+	})
+
+	t.Run("Should decode the body without status check", func(t *testing.T) {
+		body, err := httpclient.Get[apiError](client, server.URL+"/json", nil, httpclient.WithoutStatusCheck())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "invalid", body.Code)
+	})
+}
@@ -6,89 +6,225 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
-	"time"
 )
 
 type (
 	HttpClient struct {
-		httpClient *http.Client
-		ctx        context.Context
+		httpClient  *http.Client
+		ctx         context.Context
+		baseURL     string
+		headers     map[string]string
+		retryPolicy *RetryPolicy
+		limiter     *limiter
+		breaker     *circuitBreaker
+		middlewares []Middleware
+		auth        Authenticator
+		signer      Signer
+		// redactedParams are the query parameters hidden in the URLs of errors and responses
+		redactedParams []string
 	}
 )
 
-func NewClient() *HttpClient {
+func NewClient(options ...ClientOption) *HttpClient {
+	o := &clientOptions{}
+	for _, option := range options {
+		option.apply(o)
+	}
+
 	return &HttpClient{
-		httpClient: &http.Client{
-			Timeout: time.Second * 0,
-		},
-		ctx: context.Background(),
+		httpClient:     o.newHTTPClient(),
+		ctx:            context.Background(),
+		baseURL:        o.baseURL,
+		headers:        o.headers,
+		retryPolicy:    o.retryPolicy,
+		limiter:        newLimiter(o.limiterOptions()),
+		breaker:        newCircuitBreaker(o.circuitBreaker),
+		middlewares:    o.middlewares,
+		auth:           o.authenticator,
+		signer:         o.signer,
+		redactedParams: o.redactedQueryParams(),
 	}
 }
 
-func Get[T any](client *HttpClient, url string, headers map[string]string) (T, error) {
-	var m T
+func Get[T any](client *HttpClient, url string, headers map[string]string, options ...RequestOption) (T, error) {
+	return Do[T](client, http.MethodGet, url, nil, headers, options...)
+}
 
-	r, err := http.NewRequestWithContext(client.ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return m, err
-	}
+func Post[R any](client *HttpClient, url string, data any, headers map[string]string, options ...RequestOption) (R, error) {
+	return Do[R](client, http.MethodPost, url, data, headers, options...)
+}
 
-	// Important to set
-	r.Header.Add("Content-Type", "application/json")
+func Put[R any](client *HttpClient, url string, data any, headers map[string]string, options ...RequestOption) (R, error) {
+	return Do[R](client, http.MethodPut, url, data, headers, options...)
+}
 
-	for key, value := range headers {
-		r.Header.Set(key, value)
-	}
+func Patch[R any](client *HttpClient, url string, data any, headers map[string]string, options ...RequestOption) (R, error) {
+	return Do[R](client, http.MethodPatch, url, data, headers, options...)
+}
+
+func Delete[R any](client *HttpClient, url string, headers map[string]string, options ...RequestOption) (R, error) {
+	return Do[R](client, http.MethodDelete, url, nil, headers, options...)
+}
 
-	res, err := client.httpClient.Do(r)
+// Head sends a HEAD request and returns the response headers.
+func Head(client *HttpClient, url string, headers map[string]string, options ...RequestOption) (http.Header, error) {
+	res, _, err := client.send(http.MethodHead, url, nil, headers, newRequestOptions(options))
 	if err != nil {
-		return m, err
+		return nil, err
 	}
 
-	defer res.Body.Close()
+	return res.Header, nil
+}
 
-	body, err := io.ReadAll(res.Body)
+// Options sends an OPTIONS request and returns the response headers, e.g. to read the Allow header.
+func Options(client *HttpClient, url string, headers map[string]string, options ...RequestOption) (http.Header, error) {
+	res, _, err := client.send(http.MethodOptions, url, nil, headers, newRequestOptions(options))
 	if err != nil {
-		return m, err
+		return nil, err
 	}
 
-	return parseJSON[T](body)
+	return res.Header, nil
+}
+
+func GetCtx[T any](ctx context.Context, client *HttpClient, url string, headers map[string]string, options ...RequestOption) (T, error) {
+	return DoCtx[T](ctx, client, http.MethodGet, url, nil, headers, options...)
+}
+
+func PostCtx[R any](ctx context.Context, client *HttpClient, url string, data any, headers map[string]string, options ...RequestOption) (R, error) {
+	return DoCtx[R](ctx, client, http.MethodPost, url, data, headers, options...)
+}
+
+// DoCtx works like [Do], but sends the request with the given context. See [WithContext]
+func DoCtx[R any](ctx context.Context, client *HttpClient, method string, url string, data any, headers map[string]string, options ...RequestOption) (R, error) {
+	return Do[R](client, method, url, data, headers, append(options[:len(options):len(options)], WithContext(ctx))...)
 }
 
-func Post[R any](client *HttpClient, url string, data any, headers map[string]string) (R, error) {
+// Do sends a request with the given method and decodes the JSON response body into R.
+//
+// If data is not nil, it is encoded as JSON request body. An empty response body results in the zero value of R.
+// Responses with a non-2xx status code result in an [*HTTPError], unless [WithoutStatusCheck] is given.
+func Do[R any](client *HttpClient, method string, url string, data any, headers map[string]string, options ...RequestOption) (R, error) {
 	var m R
 
-	b, err := toJSON(data)
+	res, err := DoResponse[R](client, method, url, data, headers, options...)
 	if err != nil {
 		return m, err
 	}
 
-	byteReader := bytes.NewReader(b)
-	r, err := http.NewRequestWithContext(client.ctx, http.MethodPost, url, byteReader)
+	return res.Body, nil
+}
+
+func (client *HttpClient) send(method string, url string, data any, headers map[string]string, options *requestOptions) (*http.Response, []byte, error) {
+	var requestBody []byte
+
+	if data != nil {
+		b, err := toJSON(data)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		requestBody = b
+	}
+
+	ctx := client.ctx
+	if options.ctx != nil {
+		ctx = options.ctx
+	}
+
+	// A new request is created for each attempt, so that the body can be replayed safely
+	newRequest := func() (*http.Request, error) {
+		var bodyReader io.Reader
+		if requestBody != nil {
+			bodyReader = bytes.NewReader(requestBody)
+		}
+
+		r, err := http.NewRequestWithContext(ctx, method, client.resolveURL(url), bodyReader)
+		if err != nil {
+			return nil, err
+		}
+
+		// Important to set
+		r.Header.Add("Content-Type", "application/json")
+
+		for key, value := range client.headers {
+			r.Header.Set(key, value)
+		}
+
+		for key, value := range headers {
+			r.Header.Set(key, value)
+		}
+
+		return r, nil
+	}
+
+	res, body, err := client.sendWithRetry(ctx, newRequest)
 	if err != nil {
-		return m, err
+		return nil, nil, err
+	}
+
+	if !options.skipStatusCheck && !isSuccessStatus(res.StatusCode) {
+		return res, body, newHTTPError(res, client.redactURL(res.Request.URL), body, options)
+	}
+
+	return res, body, nil
+}
+
+// roundTrip sends the request and reads the complete response body.
+func (client *HttpClient) roundTrip(r *http.Request) (res *http.Response, body []byte, err error) {
+	if client.auth != nil {
+		if err := client.auth.Authenticate(r); err != nil {
+			return nil, nil, &AuthError{Err: err}
+		}
+	}
+
+	if client.breaker != nil {
+		if err := client.breaker.allow(r.URL.Host); err != nil {
+			return nil, nil, err
+		}
+
+		defer func() {
+			client.breaker.record(r.URL.Host, res, err)
+		}()
 	}
 
-	// Important to set
-	r.Header.Add("Content-Type", "application/json")
+	if client.limiter != nil {
+		release, err := client.limiter.acquire(r.Context(), r.URL.Host)
+		if err != nil {
+			return nil, nil, err
+		}
 
-	for key, value := range headers {
-		r.Header.Set(key, value)
+		defer release()
 	}
 
-	res, err := client.httpClient.Do(r)
+	res, err = client.handler()(r)
 	if err != nil {
-		return m, err
+		return nil, nil, client.redactError(err)
+	}
+
+	if res == nil {
+		return nil, nil, ErrNoResponse
+	}
+
+	// Responses created by middlewares, e.g. from a cache, may lack the request and the body
+	if res.Request == nil {
+		res.Request = r
+	}
+	if res.Body == nil {
+		res.Body = http.NoBody
 	}
 
 	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
+	body, err = io.ReadAll(res.Body)
 	if err != nil {
-		return m, err
+		return nil, nil, err
 	}
 
-	return parseJSON[R](body)
+	if client.limiter != nil {
+		client.limiter.observe(r.URL.Host, res)
+	}
+
+	return res, body, nil
 }
 
 func parseJSON[T any](s []byte) (T, error) {
@@ -101,4 +237,4 @@ func parseJSON[T any](s []byte) (T, error) {
 
 func toJSON(T any) ([]byte, error) {
 	return json.Marshal(T)
-}
\ No newline at end of file
+}
@@ -0,0 +1,97 @@
+package httpclient_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataInsightHub/Go-Helper/httpclient"
+	"github.com/stretchr/testify/assert"
+)
+
+type echo struct {
+	Method string         `json:"method"`
+	Body   map[string]any `json:"body"`
+	Header string         `json:"header"`
+}
+
+func newEchoServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Allow", "GET, POST")
+
+		if r.Method == http.MethodHead || r.Method == http.MethodOptions {
+			return
+		}
+
+		if r.URL.Path == "/empty" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		response := echo{Method: r.Method, Header: r.Header.Get("X-Test")}
+
+		body, _ := io.ReadAll(r.Body)
+		if len(body) > 0 {
+			_ = json.Unmarshal(body, &response.Body)
+		}
+
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestVerbs(t *testing.T) {
+	server := newEchoServer(t)
+	client := httpclient.NewClient()
+	headers := map[string]string{"X-Test": "value"}
+	data := map[string]any{"a": "b"}
+
+	t.Run("Should send requests without body", func(t *testing.T) {
+		for method, fn := range map[string]func() (echo, error){
+			http.MethodGet:    func() (echo, error) { return httpclient.Get[echo](client, server.URL, headers) },
+			http.MethodDelete: func() (echo, error) { return httpclient.Delete[echo](client, server.URL, headers) },
+		} {
+			res, err := fn()
+
+			assert.NoError(t, err)
+			assert.Equal(t, echo{Method: method, Header: "value"}, res)
+		}
+	})
+
+	t.Run("Should send requests with JSON body", func(t *testing.T) {
+		for method, fn := range map[string]func() (echo, error){
+			http.MethodPost:  func() (echo, error) { return httpclient.Post[echo](client, server.URL, data, headers) },
+			http.MethodPut:   func() (echo, error) { return httpclient.Put[echo](client, server.URL, data, headers) },
+			http.MethodPatch: func() (echo, error) { return httpclient.Patch[echo](client, server.URL, data, headers) },
+			"CUSTOM":         func() (echo, error) { return httpclient.Do[echo](client, "CUSTOM", server.URL, data, headers) },
+		} {
+			res, err := fn()
+
+			assert.NoError(t, err)
+			assert.Equal(t, echo{Method: method, Header: "value", Body: data}, res)
+		}
+	})
+
+	t.Run("Should return the headers of HEAD and OPTIONS requests", func(t *testing.T) {
+		header, err := httpclient.Head(client, server.URL, headers)
+		assert.NoError(t, err)
+		assert.Equal(t, "GET, POST", header.Get("Allow"))
+
+		header, err = httpclient.Options(client, server.URL, headers)
+		assert.NoError(t, err)
+		assert.Equal(t, "GET, POST", header.Get("Allow"))
+	})
+
+	t.Run("Should return the zero value for empty bodies", func(t *testing.T) {
+		res, err := httpclient.Delete[*echo](client, server.URL+"/empty", nil)
+
+		assert.NoError(t, err)
+		assert.Nil(t, res)
+	})
+}
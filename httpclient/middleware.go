@@ -0,0 +1,55 @@
+package httpclient
+
+import (
+	"errors"
+	"net/http"
+)
+
+// ErrNoResponse is returned if a [Handler] returns neither a response nor an error.
+var ErrNoResponse = errors.New("httpclient: handler returned no response")
+
+// Handler sends a request and returns its response.
+//
+// The response body is read and closed by the client after the handler chain returns.
+// A handler may return its own response, e.g. from a cache. If its Request is nil, it is set to the sent request,
+// and a nil Body is treated as empty.
+type Handler func(r *http.Request) (*http.Response, error)
+
+// Middleware wraps a Handler to add behavior around each request, e.g. auth, tracing or metrics.
+//
+// Middlewares are called for every attempt of a request, after rate limiting and circuit breaking.
+type Middleware func(next Handler) Handler
+
+type middlewareOption []Middleware
+
+func (middlewareOption middlewareOption) apply(options *clientOptions) {
+	options.middlewares = append(options.middlewares, middlewareOption...)
+}
+
+// WithMiddleware adds middlewares to the client. See [HttpClient.Use]
+func WithMiddleware(middlewares ...Middleware) ClientOption {
+	return middlewareOption(middlewares)
+}
+
+// Use adds middlewares to the client.
+//
+// The first middleware is the outermost one, i.e. it sees the request first and the response last.
+// Use must not be called concurrently with requests of the client.
+func (client *HttpClient) Use(middlewares ...Middleware) {
+	client.middlewares = append(client.middlewares, middlewares...)
+}
+
+func (client *HttpClient) handler() Handler {
+	handler := Handler(client.httpClient.Do)
+
+	// Requests are signed last, so that the signature covers all changes of the middlewares
+	if client.signer != nil {
+		handler = signingHandler(client.signer, handler)
+	}
+
+	for i := len(client.middlewares) - 1; i >= 0; i-- {
+		handler = client.middlewares[i](handler)
+	}
+
+	return handler
+}
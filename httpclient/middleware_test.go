@@ -0,0 +1,133 @@
+package httpclient_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DataInsightHub/Go-Helper/httpclient"
+	"github.com/stretchr/testify/assert"
+)
+
+func tracingMiddleware(traceID string) httpclient.Middleware {
+	return func(next httpclient.Handler) httpclient.Handler {
+		return func(r *http.Request) (*http.Response, error) {
+			r.Header.Set("X-Trace-Id", traceID)
+			return next(r)
+		}
+	}
+}
+
+func recordingMiddleware(name string, calls *[]string) httpclient.Middleware {
+	return func(next httpclient.Handler) httpclient.Handler {
+		return func(r *http.Request) (*http.Response, error) {
+			*calls = append(*calls, name+" request")
+			res, err := next(r)
+			*calls = append(*calls, name+" response")
+			return res, err
+		}
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"traceId": "` + r.Header.Get("X-Trace-Id") + `"}`))
+	}))
+	defer server.Close()
+
+	t.Run("Should call the middlewares in order", func(t *testing.T) {
+		var calls []string
+		client := httpclient.NewClient(httpclient.WithMiddleware(recordingMiddleware("outer", &calls)))
+		client.Use(recordingMiddleware("inner", &calls), tracingMiddleware("abc"))
+
+		res, err := httpclient.Get[map[string]string](client, server.URL, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", res["traceId"])
+		assert.Equal(t, []string{"outer request", "inner request", "inner response", "outer response"}, calls)
+	})
+
+	t.Run("Should return errors of middlewares", func(t *testing.T) {
+		errDenied := errors.New("denied")
+		client := httpclient.NewClient()
+		client.Use(func(next httpclient.Handler) httpclient.Handler {
+			return func(r *http.Request) (*http.Response, error) {
+				return nil, errDenied
+			}
+		})
+
+		_, err := httpclient.Get[map[string]string](client, server.URL, nil)
+
+		assert.ErrorIs(t, err, errDenied)
+	})
+
+	t.Run("Should fail if a middleware returns no response", func(t *testing.T) {
+		client := httpclient.NewClient()
+		client.Use(func(next httpclient.Handler) httpclient.Handler {
+			return func(r *http.Request) (*http.Response, error) {
+				return nil, nil
+			}
+		})
+
+		_, err := httpclient.Get[map[string]string](client, server.URL, nil)
+
+		assert.ErrorIs(t, err, httpclient.ErrNoResponse)
+	})
+
+	t.Run("Should accept responses created by middlewares", func(t *testing.T) {
+		for name, test := range map[string]struct {
+			res      *http.Response
+			expected map[string]string
+		}{
+			"with body":    {&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"traceId": "cached"}`))}, map[string]string{"traceId": "cached"}},
+			"without body": {&http.Response{StatusCode: http.StatusOK}, nil},
+		} {
+			res := test.res
+			client := httpclient.NewClient()
+			client.Use(func(next httpclient.Handler) httpclient.Handler {
+				return func(r *http.Request) (*http.Response, error) {
+					return res, nil
+				}
+			})
+
+			response, err := httpclient.GetResponse[map[string]string](client, server.URL+"/cached", nil)
+
+			assert.NoError(t, err, name)
+			assert.Equal(t, test.expected, response.Body, name)
+			assert.Equal(t, server.URL+"/cached", response.URL, name)
+		}
+	})
+
+	t.Run("Should return an HTTPError for error responses created by middlewares", func(t *testing.T) {
+		client := httpclient.NewClient()
+		client.Use(func(next httpclient.Handler) httpclient.Handler {
+			return func(r *http.Request) (*http.Response, error) {
+				return &http.Response{StatusCode: http.StatusNotFound, Status: "404 Not Found"}, nil
+			}
+		})
+
+		_, err := httpclient.Get[map[string]string](client, server.URL+"/cached", nil)
+
+		var httpErr *httpclient.HTTPError
+		assert.ErrorAs(t, err, &httpErr)
+		assert.Equal(t, http.MethodGet, httpErr.Method)
+		assert.Equal(t, server.URL+"/cached", httpErr.URL)
+	})
+
+	t.Run("Should be testable without a server", func(t *testing.T) {
+		var traceID string
+		handler := tracingMiddleware("xyz")(func(r *http.Request) (*http.Response, error) {
+			traceID = r.Header.Get("X-Trace-Id")
+			return &http.Response{StatusCode: http.StatusOK}, nil
+		})
+
+		r, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+		_, err := handler(r)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "xyz", traceID)
+	})
+}
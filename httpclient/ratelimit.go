@@ -0,0 +1,360 @@
+package httpclient
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultRateLimitPause is the time a host is paused after a 429 response without rate-limit headers.
+	defaultRateLimitPause = time.Second
+	// defaultMaxRateLimitPause is the longest time a host is paused if [WithAdaptiveRateLimit] is given without a maximum.
+	defaultMaxRateLimitPause = time.Minute
+)
+
+type (
+	limiterOptions struct {
+		rate               float64
+		burst              int
+		hostRate           float64
+		hostBurst          int
+		maxInFlight        int
+		maxInFlightPerHost int
+		// maxPause enables pausing rate-limited hosts if greater than zero
+		maxPause time.Duration
+	}
+)
+
+type rateLimitOption struct {
+	rate  float64
+	burst int
+	host  bool
+}
+
+func (rateLimitOption rateLimitOption) apply(options *clientOptions) {
+	if rateLimitOption.rate <= 0 {
+		return
+	}
+
+	if rateLimitOption.host {
+		options.limits.hostRate, options.limits.hostBurst = rateLimitOption.rate, rateLimitOption.burst
+		return
+	}
+
+	options.limits.rate, options.limits.burst = rateLimitOption.rate, rateLimitOption.burst
+}
+
+// WithRateLimit limits the requests of the client to the given rate per second,
+// allowing bursts of up to burst requests.
+func WithRateLimit(requestsPerSecond float64, burst int) ClientOption {
+	return rateLimitOption{rate: requestsPerSecond, burst: burst}
+}
+
+// WithHostRateLimit works like [WithRateLimit], but limits the requests to each host separately.
+func WithHostRateLimit(requestsPerSecond float64, burst int) ClientOption {
+	return rateLimitOption{rate: requestsPerSecond, burst: burst, host: true}
+}
+
+type maxInFlightOption struct {
+	limit int
+	host  bool
+}
+
+func (maxInFlightOption maxInFlightOption) apply(options *clientOptions) {
+	if maxInFlightOption.limit <= 0 {
+		return
+	}
+
+	if maxInFlightOption.host {
+		options.limits.maxInFlightPerHost = maxInFlightOption.limit
+		return
+	}
+
+	options.limits.maxInFlight = maxInFlightOption.limit
+}
+
+// WithMaxInFlight limits the number of concurrent requests of the client.
+func WithMaxInFlight(limit int) ClientOption {
+	return maxInFlightOption{limit: limit}
+}
+
+// WithMaxInFlightPerHost limits the number of concurrent requests to each host.
+func WithMaxInFlightPerHost(limit int) ClientOption {
+	return maxInFlightOption{limit: limit, host: true}
+}
+
+type adaptiveRateLimitOption time.Duration
+
+func (adaptiveRateLimitOption adaptiveRateLimitOption) apply(options *clientOptions) {
+	maxPause := time.Duration(adaptiveRateLimitOption)
+	if maxPause <= 0 {
+		maxPause = defaultMaxRateLimitPause
+	}
+
+	options.limits.maxPause = maxPause
+}
+
+// WithAdaptiveRateLimit pauses all requests to a host that responds with 429 or reports that its rate limit
+// is exhausted, until the limit is reset according to the rate-limit or Retry-After headers.
+//
+// A pause lasts at most maxPause, which defaults to 1 minute. If a retry policy is given as well,
+// pauses are also limited to its MaxBackoff.
+func WithAdaptiveRateLimit(maxPause time.Duration) ClientOption {
+	return adaptiveRateLimitOption(maxPause)
+}
+
+// limiterOptions returns the options of the limiter, with the pause limited to the MaxBackoff of the retry policy.
+func (options *clientOptions) limiterOptions() limiterOptions {
+	limits := options.limits
+
+	if options.retryPolicy != nil && limits.maxPause > options.retryPolicy.MaxBackoff {
+		limits.maxPause = options.retryPolicy.MaxBackoff
+	}
+
+	return limits
+}
+
+// limiter enforces the rate and concurrency limits of a client.
+//
+// If adaptive rate limiting is enabled and a host responds with 429 or reports that its rate limit is exhausted,
+// all requests to that host are paused until the limit is reset.
+type limiter struct {
+	options  limiterOptions
+	bucket   *tokenBucket
+	inFlight chan struct{}
+
+	mu    sync.Mutex
+	hosts map[string]*hostLimiter
+}
+
+type hostLimiter struct {
+	bucket      *tokenBucket
+	inFlight    chan struct{}
+	pausedUntil time.Time
+}
+
+func newLimiter(options limiterOptions) *limiter {
+	if options == (limiterOptions{}) {
+		return nil
+	}
+
+	l := &limiter{
+		options: options,
+		hosts:   make(map[string]*hostLimiter),
+	}
+
+	if options.rate > 0 {
+		l.bucket = newTokenBucket(options.rate, options.burst)
+	}
+
+	if options.maxInFlight > 0 {
+		l.inFlight = make(chan struct{}, options.maxInFlight)
+	}
+
+	return l
+}
+
+func (l *limiter) host(host string) *hostLimiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	h, exists := l.hosts[host]
+	if !exists {
+		h = &hostLimiter{}
+		if l.options.hostRate > 0 {
+			h.bucket = newTokenBucket(l.options.hostRate, l.options.hostBurst)
+		}
+		if l.options.maxInFlightPerHost > 0 {
+			h.inFlight = make(chan struct{}, l.options.maxInFlightPerHost)
+		}
+		l.hosts[host] = h
+	}
+
+	return h
+}
+
+// acquire waits until a request to host is allowed. The returned function must be called when the request is done.
+func (l *limiter) acquire(ctx context.Context, host string) (func(), error) {
+	h := l.host(host)
+
+	l.mu.Lock()
+	pause := time.Until(h.pausedUntil)
+	l.mu.Unlock()
+
+	if err := sleep(ctx, pause); err != nil {
+		return nil, err
+	}
+
+	for _, bucket := range []*tokenBucket{l.bucket, h.bucket} {
+		if err := bucket.wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	var acquired []chan struct{}
+	release := func() {
+		for _, semaphore := range acquired {
+			<-semaphore
+		}
+	}
+
+	for _, semaphore := range []chan struct{}{l.inFlight, h.inFlight} {
+		if semaphore == nil {
+			continue
+		}
+
+		select {
+		case semaphore <- struct{}{}:
+			acquired = append(acquired, semaphore)
+		case <-ctx.Done():
+			release()
+			return nil, ctx.Err()
+		}
+	}
+
+	return release, nil
+}
+
+// observe pauses the host if the response indicates that its rate limit is exhausted.
+func (l *limiter) observe(host string, res *http.Response) {
+	if l.options.maxPause <= 0 {
+		return
+	}
+
+	pause, ok := parseRateLimitReset(res)
+
+	if !ok && res.StatusCode == http.StatusTooManyRequests {
+		if pause, ok = parseRetryAfter(res); !ok {
+			pause, ok = defaultRateLimitPause, true
+		}
+	}
+
+	if !ok || pause <= 0 {
+		return
+	}
+
+	if pause > l.options.maxPause {
+		pause = l.options.maxPause
+	}
+
+	h := l.host(host)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if until := time.Now().Add(pause); until.After(h.pausedUntil) {
+		h.pausedUntil = until
+	}
+}
+
+// parseRateLimitReset returns the time until the rate limit resets if the response reports no remaining requests.
+//
+// Both the common X-RateLimit-* headers and the RateLimit-* headers of the IETF draft are supported.
+// The reset is given either in seconds or as Unix timestamp.
+func parseRateLimitReset(res *http.Response) (time.Duration, bool) {
+	for _, prefix := range []string{"X-RateLimit-", "RateLimit-"} {
+		remaining := res.Header.Get(prefix + "Remaining")
+		if remaining != "0" && !(remaining == "" && res.StatusCode == http.StatusTooManyRequests) {
+			continue
+		}
+
+		reset, err := strconv.ParseInt(res.Header.Get(prefix+"Reset"), 10, 64)
+		if err != nil || reset < 0 {
+			continue
+		}
+
+		// Values larger than a year in seconds can only be Unix timestamps
+		if reset > 365*24*60*60 {
+			return time.Until(time.Unix(reset, 0)), true
+		}
+
+		return time.Duration(reset) * time.Second, true
+	}
+
+	return 0, false
+}
+
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// wait takes a token from the bucket, waiting until one is available.
+func (bucket *tokenBucket) wait(ctx context.Context) error {
+	if bucket == nil {
+		return nil
+	}
+
+	if err := sleep(ctx, bucket.reserve()); err != nil {
+		bucket.cancel()
+		return err
+	}
+
+	return nil
+}
+
+// reserve takes a token and returns the time until it becomes available.
+func (bucket *tokenBucket) reserve() time.Duration {
+	bucket.mu.Lock()
+	defer bucket.mu.Unlock()
+
+	now := time.Now()
+	bucket.tokens += now.Sub(bucket.last).Seconds() * bucket.rate
+	if bucket.tokens > bucket.burst {
+		bucket.tokens = bucket.burst
+	}
+	bucket.last = now
+
+	bucket.tokens--
+	if bucket.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-bucket.tokens / bucket.rate * float64(time.Second))
+}
+
+// cancel returns a reserved token.
+func (bucket *tokenBucket) cancel() {
+	bucket.mu.Lock()
+	defer bucket.mu.Unlock()
+
+	bucket.tokens++
+	if bucket.tokens > bucket.burst {
+		bucket.tokens = bucket.burst
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
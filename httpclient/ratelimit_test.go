@@ -0,0 +1,157 @@
+package httpclient_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DataInsightHub/Go-Helper/fp"
+	"github.com/DataInsightHub/Go-Helper/httpclient"
+	"github.com/stretchr/testify/assert"
+)
+
+func newConcurrencyServer(t *testing.T, delay time.Duration) (*httptest.Server, *int32) {
+	t.Helper()
+
+	var active, maxActive int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&active, 1)
+		defer atomic.AddInt32(&active, -1)
+
+		for {
+			current := atomic.LoadInt32(&maxActive)
+			if n <= current || atomic.CompareAndSwapInt32(&maxActive, current, n) {
+				break
+			}
+		}
+
+		time.Sleep(delay)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &maxActive
+}
+
+// newRateLimitedServer returns a server that responds to the first request with 429 and the given Retry-After.
+func newRateLimitedServer(t *testing.T, retryAfter string) (*httptest.Server, *int32) {
+	t.Helper()
+
+	var calls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", retryAfter)
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func TestRateLimit(t *testing.T) {
+	requests := make([]int, 6)
+
+	t.Run("Should limit the request rate of the client", func(t *testing.T) {
+		server, _ := newConcurrencyServer(t, 0)
+		client := httpclient.NewClient(httpclient.WithRateLimit(100, 1))
+
+		start := time.Now()
+		err := fp.ForEachParallelWithError(requests, func(_ int, _ int) error {
+			_, err := httpclient.Get[map[string]any](client, server.URL, nil)
+			return err
+		})
+
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	})
+
+	t.Run("Should limit the request rate per host", func(t *testing.T) {
+		server1, _ := newConcurrencyServer(t, 0)
+		server2, _ := newConcurrencyServer(t, 0)
+		client := httpclient.NewClient(httpclient.WithHostRateLimit(20, 1))
+
+		start := time.Now()
+		err := fp.ForEachParallelWithError([]string{server1.URL, server2.URL}, func(_ int, url string) error {
+			_, err := httpclient.Get[map[string]any](client, url, nil)
+			return err
+		})
+
+		assert.NoError(t, err)
+		assert.Less(t, time.Since(start), 50*time.Millisecond)
+	})
+
+	t.Run("Should limit the number of concurrent requests", func(t *testing.T) {
+		server, maxActive := newConcurrencyServer(t, 5*time.Millisecond)
+		client := httpclient.NewClient(httpclient.WithMaxInFlight(2))
+
+		fp.ForEachParallel(requests, func(_ int, _ int) {
+			_, _ = httpclient.Get[map[string]any](client, server.URL, nil)
+		})
+
+		assert.Equal(t, int32(2), *maxActive)
+	})
+
+	t.Run("Should limit the number of concurrent requests per host", func(t *testing.T) {
+		server, maxActive := newConcurrencyServer(t, 5*time.Millisecond)
+		client := httpclient.NewClient(httpclient.WithMaxInFlightPerHost(1))
+
+		fp.ForEachParallel(requests, func(_ int, _ int) {
+			_, _ = httpclient.Get[map[string]any](client, server.URL, nil)
+		})
+
+		assert.Equal(t, int32(1), *maxActive)
+	})
+
+	t.Run("Should pause the host after 429 until the rate limit resets", func(t *testing.T) {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				w.Header().Set("X-RateLimit-Remaining", "0")
+				w.Header().Set("X-RateLimit-Reset", "1")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			_, _ = w.Write([]byte(`{}`))
+		}))
+		defer server.Close()
+
+		client := httpclient.NewClient(httpclient.WithAdaptiveRateLimit(time.Minute))
+
+		_, err := httpclient.Get[map[string]any](client, server.URL, nil)
+		assert.Error(t, err)
+
+		start := time.Now()
+		_, err = httpclient.Get[map[string]any](client, server.URL, nil)
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+	})
+
+	t.Run("Should limit the pause", func(t *testing.T) {
+		server, calls := newRateLimitedServer(t, "3600")
+
+		for name, client := range map[string]*httpclient.HttpClient{
+			"without adaptive rate limit": httpclient.NewClient(),
+			"with max pause":              httpclient.NewClient(httpclient.WithAdaptiveRateLimit(10 * time.Millisecond)),
+			"with retry policy": httpclient.NewClient(
+				httpclient.WithAdaptiveRateLimit(time.Minute),
+				httpclient.WithRetryPolicy(httpclient.RetryPolicy{MaxBackoff: 10 * time.Millisecond}),
+			),
+		} {
+			atomic.StoreInt32(calls, 0)
+
+			start := time.Now()
+			_, _ = httpclient.Get[map[string]any](client, server.URL, nil)
+			_, err := httpclient.Get[map[string]any](client, server.URL, nil)
+
+			assert.NoError(t, err, name)
+			assert.Less(t, time.Since(start), time.Second, name)
+		}
+	})
+}
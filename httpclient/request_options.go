@@ -0,0 +1,71 @@
+package httpclient
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type RequestOption interface {
+	apply(*requestOptions)
+}
+
+type (
+	requestOptions struct {
+		ctx             context.Context
+		decodeErrorBody func([]byte) (any, error)
+		skipStatusCheck bool
+	}
+)
+
+func newRequestOptions(options []RequestOption) *requestOptions {
+	o := &requestOptions{}
+	for _, option := range options {
+		option.apply(o)
+	}
+	return o
+}
+
+type errorBodyOption[E any] struct{}
+
+func (errorBodyOption[E]) apply(options *requestOptions) {
+	options.decodeErrorBody = func(body []byte) (any, error) {
+		var e E
+		if err := json.Unmarshal(body, &e); err != nil {
+			return nil, err
+		}
+		return e, nil
+	}
+}
+
+// WithErrorBody decodes the body of non-2xx responses into E and stores it in [HTTPError.ErrorBody].
+//
+// See [ErrorBodyAs]
+func WithErrorBody[E any]() RequestOption {
+	return errorBodyOption[E]{}
+}
+
+type skipStatusCheckOption struct{}
+
+func (skipStatusCheckOption) apply(options *requestOptions) {
+	options.skipStatusCheck = true
+}
+
+// WithoutStatusCheck decodes the response body regardless of the status code instead of returning an [*HTTPError].
+func WithoutStatusCheck() RequestOption {
+	return skipStatusCheckOption{}
+}
+
+type contextOption struct {
+	ctx context.Context
+}
+
+func (contextOption contextOption) apply(options *requestOptions) {
+	options.ctx = contextOption.ctx
+}
+
+// WithContext sends the request with the given context.
+//
+// Canceling the context aborts both the request and reading the response body.
+func WithContext(ctx context.Context) RequestOption {
+	return contextOption{ctx: ctx}
+}
@@ -0,0 +1,100 @@
+package httpclient
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Response holds the decoded response body together with the metadata of the response.
+type Response[T any] struct {
+	Body       T
+	StatusCode int
+	Header     http.Header
+	// ContentLength is the number of bytes of the response body.
+	ContentLength int64
+	// Duration is the time from sending the request until the response body was read.
+	Duration time.Duration
+	// URL is the final URL of the request after following redirects.
+	URL string
+}
+
+// Links parses the Link header (RFC 8288) and returns the target URL for each relation type,
+// e.g. to follow the "next" page of a paginated API.
+func (res *Response[T]) Links() map[string]string {
+	links := make(map[string]string)
+
+	for _, header := range res.Header.Values("Link") {
+		for _, link := range strings.Split(header, ",") {
+			parts := strings.Split(link, ";")
+
+			target := strings.TrimSpace(parts[0])
+			if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+			target = target[1 : len(target)-1]
+
+			for _, param := range parts[1:] {
+				key, value, found := strings.Cut(strings.TrimSpace(param), "=")
+				if !found || !strings.EqualFold(key, "rel") {
+					continue
+				}
+
+				for _, rel := range strings.Fields(strings.Trim(value, `"`)) {
+					links[rel] = target
+				}
+			}
+		}
+	}
+
+	return links
+}
+
+func GetResponse[T any](client *HttpClient, url string, headers map[string]string, options ...RequestOption) (*Response[T], error) {
+	return DoResponse[T](client, http.MethodGet, url, nil, headers, options...)
+}
+
+func PostResponse[R any](client *HttpClient, url string, data any, headers map[string]string, options ...RequestOption) (*Response[R], error) {
+	return DoResponse[R](client, http.MethodPost, url, data, headers, options...)
+}
+
+func PutResponse[R any](client *HttpClient, url string, data any, headers map[string]string, options ...RequestOption) (*Response[R], error) {
+	return DoResponse[R](client, http.MethodPut, url, data, headers, options...)
+}
+
+func PatchResponse[R any](client *HttpClient, url string, data any, headers map[string]string, options ...RequestOption) (*Response[R], error) {
+	return DoResponse[R](client, http.MethodPatch, url, data, headers, options...)
+}
+
+func DeleteResponse[R any](client *HttpClient, url string, headers map[string]string, options ...RequestOption) (*Response[R], error) {
+	return DoResponse[R](client, http.MethodDelete, url, nil, headers, options...)
+}
+
+// DoResponse works like [Do], but returns the decoded body together with the metadata of the response.
+func DoResponse[R any](client *HttpClient, method string, url string, data any, headers map[string]string, options ...RequestOption) (*Response[R], error) {
+	start := time.Now()
+
+	res, body, err := client.send(method, url, data, headers, newRequestOptions(options))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &Response[R]{
+		StatusCode:    res.StatusCode,
+		Header:        res.Header,
+		ContentLength: int64(len(body)),
+		Duration:      time.Since(start),
+		URL:           client.redactURL(res.Request.URL),
+	}
+
+	if len(body) == 0 {
+		return response, nil
+	}
+
+	response.Body, err = parseJSON[R](body)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
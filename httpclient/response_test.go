@@ -0,0 +1,54 @@
+package httpclient_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataInsightHub/Go-Helper/httpclient"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDoResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/old" {
+			http.Redirect(w, r, "/new", http.StatusFound)
+			return
+		}
+
+		w.Header().Set("ETag", `"abc"`)
+		w.Header().Set("X-RateLimit-Remaining", "9")
+		w.Header().Add("Link", `<https://api.example.com/items?page=2>; rel="next", <https://api.example.com/items?page=5>; rel="last"`)
+		w.Header().Add("Link", `<https://api.example.com/items?page=1>; rel="first prev"`)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": 1}`))
+	}))
+	defer server.Close()
+
+	client := httpclient.NewClient()
+
+	t.Run("Should return the body with metadata", func(t *testing.T) {
+		res, err := httpclient.PostResponse[map[string]int](client, server.URL+"/old", nil, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int{"id": 1}, res.Body)
+		assert.Equal(t, http.StatusCreated, res.StatusCode)
+		assert.Equal(t, `"abc"`, res.Header.Get("ETag"))
+		assert.Equal(t, "9", res.Header.Get("X-RateLimit-Remaining"))
+		assert.Equal(t, int64(9), res.ContentLength)
+		assert.Equal(t, server.URL+"/new", res.URL)
+		assert.Greater(t, int64(res.Duration), int64(0))
+	})
+
+	t.Run("Should parse Link headers", func(t *testing.T) {
+		res, err := httpclient.GetResponse[map[string]int](client, server.URL, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"next":  "https://api.example.com/items?page=2",
+			"last":  "https://api.example.com/items?page=5",
+			"first": "https://api.example.com/items?page=1",
+			"prev":  "https://api.example.com/items?page=1",
+		}, res.Links())
+	})
+}
@@ -0,0 +1,179 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures automatic retries of failed requests.
+//
+// Requests are retried on transport errors, e.g. connection resets, and on the configured status codes.
+// The zero value of each field selects its default.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts including the first one. Defaults to 3.
+	MaxAttempts int
+	// InitialBackoff is the wait time before the first retry. Defaults to 100ms.
+	InitialBackoff time.Duration
+	// MaxBackoff limits the wait time between two attempts. Defaults to 10s.
+	MaxBackoff time.Duration
+	// Multiplier is the factor the wait time grows with after each retry. Defaults to 2.
+	Multiplier float64
+	// Jitter randomizes each wait time by up to the given fraction, e.g. 0.2 for ±20%. Defaults to no jitter.
+	Jitter float64
+	// StatusCodes are the status codes to retry. Defaults to 429, 502, 503 and 504.
+	StatusCodes []int
+	// RetryNonIdempotent also retries POST, PATCH and other non-idempotent methods.
+	RetryNonIdempotent bool
+}
+
+var defaultRetryStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+type retryPolicyOption RetryPolicy
+
+func (retryPolicyOption retryPolicyOption) apply(options *clientOptions) {
+	policy := RetryPolicy(retryPolicyOption)
+
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = 3
+	}
+	if policy.InitialBackoff <= 0 {
+		policy.InitialBackoff = 100 * time.Millisecond
+	}
+	if policy.MaxBackoff <= 0 {
+		policy.MaxBackoff = 10 * time.Second
+	}
+	if policy.Multiplier < 1 {
+		policy.Multiplier = 2
+	}
+	if policy.StatusCodes == nil {
+		policy.StatusCodes = defaultRetryStatusCodes
+	}
+
+	options.retryPolicy = &policy
+}
+
+// WithRetryPolicy retries failed requests with exponential backoff.
+//
+// A Retry-After header of the response takes precedence over the computed backoff,
+// but the wait time never exceeds MaxBackoff.
+// The request body is replayed for each attempt.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return retryPolicyOption(policy)
+}
+
+func (client *HttpClient) sendWithRetry(ctx context.Context, newRequest func() (*http.Request, error)) (*http.Response, []byte, error) {
+	policy := client.retryPolicy
+
+	for attempt := 1; ; attempt++ {
+		r, err := newRequest()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		res, body, err := client.roundTrip(r)
+
+		if policy == nil || attempt >= policy.MaxAttempts || !policy.shouldRetry(r, res, err) {
+			return res, body, err
+		}
+
+		if err := sleep(ctx, policy.backoff(attempt, res)); err != nil {
+			return nil, nil, err
+		}
+	}
+}
+
+func (policy *RetryPolicy) shouldRetry(r *http.Request, res *http.Response, err error) bool {
+	if !policy.RetryNonIdempotent && !isIdempotent(r.Method) {
+		return false
+	}
+
+	if err != nil {
+		var authErr *AuthError
+		if errors.As(err, &authErr) {
+			return false
+		}
+
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, ErrCircuitOpen)
+	}
+
+	for _, statusCode := range policy.StatusCodes {
+		if res.StatusCode == statusCode {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff returns the wait time after the given attempt.
+func (policy *RetryPolicy) backoff(attempt int, res *http.Response) time.Duration {
+	if retryAfter, ok := parseRetryAfter(res); ok {
+		if retryAfter > policy.MaxBackoff {
+			return policy.MaxBackoff
+		}
+		return retryAfter
+	}
+
+	backoff := float64(policy.InitialBackoff)
+	for i := 1; i < attempt; i++ {
+		backoff *= policy.Multiplier
+	}
+
+	if backoff > float64(policy.MaxBackoff) {
+		backoff = float64(policy.MaxBackoff)
+	}
+
+	if policy.Jitter > 0 {
+		backoff += backoff * policy.Jitter * (2*rand.Float64() - 1)
+	}
+
+	// Clamped again, so that the jitter cannot exceed MaxBackoff
+	if backoff > float64(policy.MaxBackoff) {
+		backoff = float64(policy.MaxBackoff)
+	}
+
+	return time.Duration(backoff)
+}
+
+// parseRetryAfter parses the Retry-After header, given either in seconds or as HTTP date.
+func parseRetryAfter(res *http.Response) (time.Duration, bool) {
+	if res == nil {
+		return 0, false
+	}
+
+	header := res.Header.Get("Retry-After")
+	if header == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(header); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
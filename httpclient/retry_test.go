@@ -0,0 +1,158 @@
+package httpclient_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DataInsightHub/Go-Helper/httpclient"
+	"github.com/stretchr/testify/assert"
+)
+
+// newFlakyServer fails the first failures requests with the given status code,
+// or by closing the connection if statusCode is 0.
+func newFlakyServer(t *testing.T, failures int32, statusCode int, header http.Header) (*httptest.Server, *int32, *[]string) {
+	t.Helper()
+
+	var (
+		calls  int32
+		bodies []string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+
+		if n <= failures {
+			if statusCode == 0 {
+				conn, _, _ := w.(http.Hijacker).Hijack()
+				conn.Close()
+				return
+			}
+
+			for key, values := range header {
+				w.Header()[key] = values
+			}
+			w.WriteHeader(statusCode)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"ok": true}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls, &bodies
+}
+
+func TestRetryPolicy(t *testing.T) {
+	policy := httpclient.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, Jitter: 0.5}
+
+	t.Run("Should retry retryable status codes", func(t *testing.T) {
+		server, calls, _ := newFlakyServer(t, 2, http.StatusServiceUnavailable, nil)
+		client := httpclient.NewClient(httpclient.WithRetryPolicy(policy))
+
+		res, err := httpclient.Get[map[string]bool](client, server.URL, nil)
+
+		assert.NoError(t, err)
+		assert.True(t, res["ok"])
+		assert.Equal(t, int32(3), *calls)
+	})
+
+	t.Run("Should give up after MaxAttempts", func(t *testing.T) {
+		server, calls, _ := newFlakyServer(t, 5, http.StatusBadGateway, nil)
+		client := httpclient.NewClient(httpclient.WithRetryPolicy(policy))
+
+		_, err := httpclient.Get[map[string]bool](client, server.URL, nil)
+
+		var httpErr *httpclient.HTTPError
+		assert.ErrorAs(t, err, &httpErr)
+		assert.Equal(t, http.StatusBadGateway, httpErr.StatusCode)
+		assert.Equal(t, int32(3), *calls)
+	})
+
+	t.Run("Should not retry other status codes", func(t *testing.T) {
+		server, calls, _ := newFlakyServer(t, 1, http.StatusInternalServerError, nil)
+		client := httpclient.NewClient(httpclient.WithRetryPolicy(policy))
+
+		_, err := httpclient.Get[map[string]bool](client, server.URL, nil)
+
+		assert.Error(t, err)
+		assert.Equal(t, int32(1), *calls)
+	})
+
+	t.Run("Should retry connection resets", func(t *testing.T) {
+		server, calls, _ := newFlakyServer(t, 1, 0, nil)
+		client := httpclient.NewClient(httpclient.WithRetryPolicy(policy))
+
+		_, err := httpclient.Delete[map[string]bool](client, server.URL, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), *calls)
+	})
+
+	t.Run("Should honor Retry-After", func(t *testing.T) {
+		server, calls, _ := newFlakyServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": {"1"}})
+		client := httpclient.NewClient(httpclient.WithRetryPolicy(policy))
+
+		start := time.Now()
+		_, err := httpclient.Get[map[string]bool](client, server.URL, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), *calls)
+		assert.GreaterOrEqual(t, time.Since(start), time.Second)
+	})
+
+	t.Run("Should cap Retry-After at MaxBackoff", func(t *testing.T) {
+		server, calls, _ := newFlakyServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": {"60"}})
+		client := httpclient.NewClient(httpclient.WithRetryPolicy(httpclient.RetryPolicy{MaxBackoff: 10 * time.Millisecond}))
+
+		start := time.Now()
+		_, err := httpclient.Get[map[string]bool](client, server.URL, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), *calls)
+		assert.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("Should not retry non-idempotent methods by default", func(t *testing.T) {
+		server, calls, _ := newFlakyServer(t, 1, http.StatusServiceUnavailable, nil)
+		client := httpclient.NewClient(httpclient.WithRetryPolicy(policy))
+
+		_, err := httpclient.Post[map[string]bool](client, server.URL, map[string]int{"a": 1}, nil)
+
+		assert.Error(t, err)
+		assert.Equal(t, int32(1), *calls)
+	})
+
+	t.Run("Should replay the body when retrying non-idempotent methods", func(t *testing.T) {
+		server, calls, bodies := newFlakyServer(t, 1, http.StatusServiceUnavailable, nil)
+		nonIdempotent := policy
+		nonIdempotent.RetryNonIdempotent = true
+		client := httpclient.NewClient(httpclient.WithRetryPolicy(nonIdempotent))
+
+		_, err := httpclient.Post[map[string]bool](client, server.URL, map[string]int{"a": 1}, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), *calls)
+		assert.Equal(t, []string{`{"a":1}`, `{"a":1}`}, *bodies)
+	})
+
+	t.Run("Should stop waiting when the context is canceled", func(t *testing.T) {
+		server, calls, _ := newFlakyServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": {"60"}})
+		client := httpclient.NewClient(httpclient.WithRetryPolicy(policy))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := httpclient.GetCtx[map[string]bool](ctx, client, server.URL, nil)
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, int32(1), *calls)
+	})
+}
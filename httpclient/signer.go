@@ -0,0 +1,312 @@
+package httpclient
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Signer signs a request, e.g. by adding a signature header.
+//
+// Sign is called for every attempt of a request with the exact bytes of the request body,
+// after authentication and all middlewares.
+type Signer interface {
+	Sign(r *http.Request, body []byte) error
+}
+
+type signerOption struct {
+	signer Signer
+}
+
+func (signerOption signerOption) apply(options *clientOptions) {
+	options.signer = signerOption.signer
+}
+
+// WithSigner signs every request of the client.
+func WithSigner(signer Signer) ClientOption {
+	return signerOption{signer: signer}
+}
+
+func signingHandler(signer Signer, next Handler) Handler {
+	return func(r *http.Request) (*http.Response, error) {
+		body, err := readRequestBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := signer.Sign(r, body); err != nil {
+			return nil, err
+		}
+
+		return next(r)
+	}
+}
+
+// readRequestBody reads the request body and replaces it with a buffered copy.
+//
+// r.Body is read instead of r.GetBody, since middlewares may have replaced the body.
+func readRequestBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(r.Body)
+	r.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	r.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	r.ContentLength = int64(len(body))
+
+	return body, nil
+}
+
+// HMACSigner signs requests with HMAC-SHA256 over a canonical string of the request.
+//
+// The zero value of each field except Secret selects its default.
+type HMACSigner struct {
+	Secret []byte
+	// KeyID is sent in KeyIDHeader if not empty, so that the server can look up the secret.
+	KeyID string
+	// SignatureHeader receives the hex encoded signature. Defaults to "X-Signature".
+	SignatureHeader string
+	// TimestampHeader receives the Unix timestamp included in the signature. Defaults to "X-Timestamp".
+	TimestampHeader string
+	// KeyIDHeader receives the KeyID. Defaults to "X-Key-Id".
+	KeyIDHeader string
+	// SignedHeaders are included in the default canonical string.
+	SignedHeaders []string
+	// CanonicalString builds the string to sign. Defaults to [DefaultHMACCanonicalString].
+	CanonicalString func(r *http.Request, body []byte, timestamp string, signedHeaders []string) string
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// DefaultHMACCanonicalString joins the method, the escaped path, the raw query, the timestamp,
+// each signed header as "name:value" with a lowercase name and the hex encoded SHA-256 hash of the body
+// with newlines.
+func DefaultHMACCanonicalString(r *http.Request, body []byte, timestamp string, signedHeaders []string) string {
+	parts := []string{r.Method, r.URL.EscapedPath(), r.URL.RawQuery, timestamp}
+
+	for _, header := range signedHeaders {
+		parts = append(parts, strings.ToLower(header)+":"+strings.TrimSpace(r.Header.Get(header)))
+	}
+
+	return strings.Join(append(parts, sha256Hex(body)), "\n")
+}
+
+func (signer *HMACSigner) Sign(r *http.Request, body []byte) error {
+	now, canonicalString := time.Now, DefaultHMACCanonicalString
+	if signer.Now != nil {
+		now = signer.Now
+	}
+	if signer.CanonicalString != nil {
+		canonicalString = signer.CanonicalString
+	}
+
+	timestamp := strconv.FormatInt(now().Unix(), 10)
+	r.Header.Set(headerOrDefault(signer.TimestampHeader, "X-Timestamp"), timestamp)
+
+	if signer.KeyID != "" {
+		r.Header.Set(headerOrDefault(signer.KeyIDHeader, "X-Key-Id"), signer.KeyID)
+	}
+
+	signature := hmacSHA256(signer.Secret, canonicalString(r, body, timestamp, signer.SignedHeaders))
+	r.Header.Set(headerOrDefault(signer.SignatureHeader, "X-Signature"), hex.EncodeToString(signature))
+
+	return nil
+}
+
+func headerOrDefault(header string, fallback string) string {
+	if header == "" {
+		return fallback
+	}
+	return header
+}
+
+// SigV4Signer signs requests with the AWS Signature Version 4 algorithm.
+//
+// The host, the Content-Type and all X-Amz-* headers are signed.
+type SigV4Signer struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	// SessionToken is sent in the X-Amz-Security-Token header if not empty.
+	SessionToken string
+	Region       string
+	Service      string
+	// SignPayloadHeader sends the hash of the body in the X-Amz-Content-Sha256 header, as required by S3.
+	SignPayloadHeader bool
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+}
+
+const sigV4Algorithm = "AWS4-HMAC-SHA256"
+
+func (signer *SigV4Signer) Sign(r *http.Request, body []byte) error {
+	now := time.Now
+	if signer.Now != nil {
+		now = signer.Now
+	}
+
+	t := now().UTC()
+	amzDate := t.Format("20060102T150405Z")
+	date := t.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	r.Header.Set("X-Amz-Date", amzDate)
+	if signer.SessionToken != "" {
+		r.Header.Set("X-Amz-Security-Token", signer.SessionToken)
+	}
+	if signer.SignPayloadHeader {
+		r.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	}
+
+	canonicalHeaders, signedHeaders := signer.canonicalHeaders(r)
+
+	canonicalRequest := strings.Join([]string{
+		r.Method,
+		signer.canonicalURI(r.URL),
+		canonicalQuery(r.URL),
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{date, signer.Region, signer.Service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{sigV4Algorithm, amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+signer.SecretAccessKey), date)
+	for _, part := range []string{signer.Region, signer.Service, "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	r.Header.Set("Authorization", sigV4Algorithm+
+		" Credential="+signer.AccessKeyID+"/"+scope+
+		", SignedHeaders="+signedHeaders+
+		", Signature="+signature)
+
+	return nil
+}
+
+func (signer *SigV4Signer) canonicalHeaders(r *http.Request) (string, string) {
+	host := r.Host
+	if host == "" {
+		host = r.URL.Host
+	}
+
+	headers := map[string]string{"host": host}
+	for name, values := range r.Header {
+		name = strings.ToLower(name)
+		if name == "content-type" || strings.HasPrefix(name, "x-amz-") {
+			trimmed := make([]string, len(values))
+			for i, value := range values {
+				trimmed[i] = strings.Join(strings.Fields(value), " ")
+			}
+			headers[name] = strings.Join(trimmed, ",")
+		}
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		b.WriteString(name + ":" + headers[name] + "\n")
+	}
+
+	return b.String(), strings.Join(names, ";")
+}
+
+// canonicalURI encodes each decoded path segment, twice for all services except S3.
+func (signer *SigV4Signer) canonicalURI(u *url.URL) string {
+	path := u.EscapedPath()
+	if path == "" {
+		return "/"
+	}
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if decoded, err := url.PathUnescape(segment); err == nil {
+			segment = decoded
+		}
+
+		segment = awsURIEncode(segment)
+		if signer.Service != "s3" {
+			segment = awsURIEncode(segment)
+		}
+
+		segments[i] = segment
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// canonicalQuery encodes the query parameters sorted by key and then by value.
+func canonicalQuery(u *url.URL) string {
+	type pair struct {
+		key, value string
+	}
+
+	var pairs []pair
+	for key, values := range u.Query() {
+		for _, value := range values {
+			pairs = append(pairs, pair{key: awsURIEncode(key), value: awsURIEncode(value)})
+		}
+	}
+
+	// Sorting the joined pairs would be wrong if a key is a prefix of another, e.g. "a=1" and "a-b=2"
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].key != pairs[j].key {
+			return pairs[i].key < pairs[j].key
+		}
+		return pairs[i].value < pairs[j].value
+	})
+
+	encoded := make([]string, len(pairs))
+	for i, pair := range pairs {
+		encoded[i] = pair.key + "=" + pair.value
+	}
+
+	return strings.Join(encoded, "&")
+}
+
+// awsURIEncode percent-encodes all characters except the unreserved characters of RFC 3986.
+func awsURIEncode(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+			continue
+		}
+		b.WriteString("%" + strings.ToUpper(hex.EncodeToString([]byte{c})))
+	}
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func sha256Hex(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}